@@ -0,0 +1,368 @@
+package gotaseries
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// CacheEntry is a response body stored in a Cache.
+type CacheEntry struct {
+	Body []byte
+	// ETag of the response, used to revalidate the entry once expired.
+	ETag    string
+	Expires time.Time
+	// Tags identify the entries to invalidate together, e.g. "show:1161".
+	Tags []string
+}
+
+// Cache stores the responses of the GET requests, see WithCache.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry of the key, including an expired one.
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	// Invalidate removes the entries having the tag.
+	Invalidate(tag string)
+}
+
+// CacheTTLs are the durations the responses are cached for, by endpoint path.
+// The responses of the endpoints missing from the map are not cached.
+type CacheTTLs map[string]time.Duration
+
+// DefaultCacheTTLs returns the TTLs of the endpoints returning data which
+// rarely changes.
+func DefaultCacheTTLs() CacheTTLs {
+	return CacheTTLs{
+		"/shows/display":    time.Hour,
+		"/shows/seasons":    time.Hour,
+		"/shows/characters": 24 * time.Hour,
+		"/shows/genres":     24 * time.Hour,
+		"/movies/genres":    24 * time.Hour,
+	}
+}
+
+// cacheRequest is the cache state of a cacheable GET request.
+type cacheRequest struct {
+	key    string
+	urlStr string
+	ttl    time.Duration
+	tags   []string
+	entry  *CacheEntry
+}
+
+// cacheRequest returns the cache state of the request, nil when its response
+// is not cached.
+func (c *Client) cacheRequest(req *http.Request, urlStr string) *cacheRequest {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return nil
+	}
+
+	ttl := c.cacheTTLs[urlStr]
+	if ttl <= 0 {
+		return nil
+	}
+
+	cr := &cacheRequest{
+		key:    cacheKey(req),
+		urlStr: urlStr,
+		ttl:    ttl,
+		tags:   cacheTags(urlStr, requestParams(req)),
+	}
+	cr.entry, _ = c.cache.Get(cr.key)
+
+	return cr
+}
+
+// cacheKey returns the key of a request built from its path, its parameters,
+// the locale included, and a hash of its token.
+func cacheKey(req *http.Request) string {
+	key := req.Method + " " + req.URL.Path + "?" + req.URL.Query().Encode()
+
+	if token := req.Header.Get("X-BetaSeries-Token"); token != "" {
+		sum := sha256.Sum256([]byte(token))
+		key += " " + hex.EncodeToString(sum[:8])
+	}
+
+	return key
+}
+
+// showEndpoints maps the endpoints about a show to the parameter holding its
+// Betaseries id. The show can also be given by its TheTVDB or IMDb id.
+var showEndpoints = map[string]string{
+	"/shows/display":    "id",
+	"/shows/episodes":   "id",
+	"/shows/show":       "id",
+	"/shows/archive":    "id",
+	"/shows/note":       "id",
+	"/shows/similars":   "id",
+	"/shows/videos":     "id",
+	"/shows/characters": "id",
+	"/shows/pictures":   "id",
+	"/shows/favorite":   "id",
+	"/shows/tags":       "id",
+	"/shows/seasons":    "id",
+	"/shows/articles":   "id",
+}
+
+// episodeEndpoints are the endpoints about an episode, which return it along
+// with its show. Modifying an episode, e.g. marking it as watched, changes the
+// progress returned by the endpoints about its show.
+var episodeEndpoints = map[string]bool{
+	"/episodes/display":    true,
+	"/episodes/watched":    true,
+	"/episodes/downloaded": true,
+	"/episodes/note":       true,
+	"/episodes/hidden":     true,
+}
+
+// cacheTags returns the tags of a request on a show, one for each of the ids
+// of the show given by its parameters, e.g. "show:1161" or
+// "show:thetvdb:121361".
+func cacheTags(urlStr string, params map[string][]string) []string {
+	param, ok := showEndpoints[urlStr]
+	if !ok {
+		return nil
+	}
+
+	return showTags(first(params[param]), first(params["thetvdb_id"]), first(params["imdb_id"]))
+}
+
+// showIDs are the ids of the show of a response.
+type showIDs struct {
+	ID        int    `json:"id"`
+	TheTvdbID int    `json:"thetvdb_id"`
+	ImdbID    string `json:"imdb_id"`
+}
+
+// responseTags returns the tags of the show of the response of a request on
+// a show or on one of its episodes, so that a show given by any of its ids is
+// tagged with all of them.
+func responseTags(urlStr string, body []byte) []string {
+	_, onShow := showEndpoints[urlStr]
+	if !onShow && !episodeEndpoints[urlStr] {
+		return nil
+	}
+
+	var res struct {
+		Show    *showIDs `json:"show"`
+		Episode *struct {
+			Show *showIDs `json:"show"`
+		} `json:"episode"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil
+	}
+
+	var show *showIDs
+	switch {
+	case onShow:
+		show = res.Show
+	case res.Episode != nil:
+		show = res.Episode.Show
+	}
+	if show == nil {
+		return nil
+	}
+
+	var id, thetvdbID string
+	if show.ID != 0 {
+		id = strconv.Itoa(show.ID)
+	}
+	if show.TheTvdbID != 0 {
+		thetvdbID = strconv.Itoa(show.TheTvdbID)
+	}
+
+	return showTags(id, thetvdbID, show.ImdbID)
+}
+
+func showTags(id, thetvdbID, imdbID string) []string {
+	var tags []string
+	if id != "" {
+		tags = append(tags, "show:"+id)
+	}
+	if thetvdbID != "" {
+		tags = append(tags, "show:thetvdb:"+thetvdbID)
+	}
+	if imdbID != "" {
+		tags = append(tags, "show:imdb:"+imdbID)
+	}
+	return tags
+}
+
+// mergeTags returns the tags of a and b without duplicates.
+func mergeTags(a, b []string) []string {
+	tags := append([]string(nil), a...)
+	for _, t := range b {
+		if !containsTag(tags, t) {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// fresh reports whether the cached entry can be used without a request.
+func (cr *cacheRequest) fresh() bool {
+	return cr != nil && cr.entry != nil && time.Now().Before(cr.entry.Expires)
+}
+
+// revalidate sets the ETag of an expired entry on the request.
+func (cr *cacheRequest) revalidate(req *http.Request) {
+	if cr != nil && cr.entry != nil && cr.entry.ETag != "" {
+		req.Header.Set("If-None-Match", cr.entry.ETag)
+	}
+}
+
+// store caches the response and its body, or refreshes the cached entry on a
+// 304 status. It returns the body decoded into response, the one of the cached
+// entry on a 304 status.
+func (c *Client) store(cr *cacheRequest, res *http.Response, body []byte, response errorableResponse) ([]byte, error) {
+	if res.StatusCode == http.StatusNotModified && cr.entry != nil {
+		entry := *cr.entry
+		entry.Expires = time.Now().Add(cr.ttl)
+		c.cache.Set(cr.key, &entry)
+		return entry.Body, json.Unmarshal(entry.Body, response)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return body, nil
+	}
+
+	c.cache.Set(cr.key, &CacheEntry{
+		Body:    body,
+		ETag:    res.Header.Get("ETag"),
+		Expires: time.Now().Add(cr.ttl),
+		Tags:    mergeTags(cr.tags, responseTags(cr.urlStr, body)),
+	})
+
+	return body, nil
+}
+
+// invalidate removes the cached entries of the show modified by a request,
+// given by the parameters of the request or by its response.
+func (c *Client) invalidate(req *http.Request, urlStr string, body []byte) {
+	if c.cache == nil || req.Method == http.MethodGet {
+		return
+	}
+
+	tags := mergeTags(cacheTags(urlStr, requestParams(req)), responseTags(urlStr, body))
+	for _, tag := range tags {
+		c.cache.Invalidate(tag)
+	}
+}
+
+// LRUCache is an in-memory Cache evicting the least recently used entries.
+type LRUCache struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]*list.Element
+	order   *list.List
+	tags    map[string]map[string]struct{}
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns a cache holding up to maxEntries entries.
+//
+// Example:
+//
+//	client, err := gotaseries.NewClient("YOUR_API_KEY",
+//		gotaseries.WithCache(gotaseries.NewLRUCache(1000), gotaseries.DefaultCacheTTLs()),
+//	)
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		max:     maxEntries,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		tags:    make(map[string]map[string]struct{}),
+	}
+}
+
+func (l *LRUCache) Get(key string) (*CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	l.order.MoveToFront(e)
+	return e.Value.(*lruItem).entry, true
+}
+
+func (l *LRUCache) Set(key string, entry *CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e, ok := l.entries[key]; ok {
+		l.remove(e)
+	}
+
+	l.entries[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+	for _, tag := range entry.Tags {
+		if l.tags[tag] == nil {
+			l.tags[tag] = make(map[string]struct{})
+		}
+		l.tags[tag][key] = struct{}{}
+	}
+
+	for l.max > 0 && l.order.Len() > l.max {
+		l.remove(l.order.Back())
+	}
+}
+
+func (l *LRUCache) Invalidate(tag string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key := range l.tags[tag] {
+		if e, ok := l.entries[key]; ok {
+			l.remove(e)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
+
+func (l *LRUCache) remove(e *list.Element) {
+	item := l.order.Remove(e).(*lruItem)
+	delete(l.entries, item.key)
+
+	for _, tag := range item.entry.Tags {
+		delete(l.tags[tag], item.key)
+		if len(l.tags[tag]) == 0 {
+			delete(l.tags, tag)
+		}
+	}
+}
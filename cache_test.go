@@ -0,0 +1,284 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCache(t *testing.T, ttls CacheTTLs, opts ...Option) (*httptest.Server, *Client, *int32) {
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		if r.Method != http.MethodGet {
+			body := `{"show": {"id": 1161, "thetvdb_id": 121361}}`
+			if strings.HasPrefix(r.URL.Path, "/episodes/") {
+				body = `{"episode": {"id": 253454, "show": {"id": 1161, "thetvdb_id": 121361}}}`
+			}
+			_, _ = w.Write([]byte(body))
+			return
+		}
+
+		etag := `"v1"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		// Game of Thrones is also known by its TheTVDB id.
+		id, thetvdbID := r.URL.Query().Get("id"), "0"
+		if id == "1161" || r.URL.Query().Get("thetvdb_id") == "121361" {
+			id, thetvdbID = "1161", "121361"
+		}
+
+		w.Header().Set("ETag", etag)
+		_, _ = fmt.Fprintf(w, `{"show": {"id": %s, "thetvdb_id": %s, "title": "request %d"}}`, id, thetvdbID, n)
+	}))
+
+	opts = append([]Option{WithBaseURL(ts.URL), WithCache(NewLRUCache(10), ttls)}, opts...)
+	bc, err := NewClient("api_key", opts...)
+	assert.NoError(t, err)
+
+	return ts, bc, &requests
+}
+
+func TestCache_Hit(t *testing.T) {
+	ts, bc, requests := setupCache(t, nil)
+	defer ts.Close()
+
+	for i := 0; i < 3; i++ {
+		show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+		assert.Equal(t, "request 1", show.Title)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestCache_Key(t *testing.T) {
+	ts, bc, requests := setupCache(t, nil)
+	defer ts.Close()
+
+	ctx := context.Background()
+	params := ShowsDisplayParams{ID: Int(1161)}
+
+	_, err := bc.Shows.Display(ctx, params)
+	assert.NoError(t, err)
+	_, err = bc.WithToken("token").Shows.Display(ctx, params)
+	assert.NoError(t, err)
+	_, err = bc.WithToken("other").Shows.Display(ctx, params)
+	assert.NoError(t, err)
+	_, err = bc.WithLocale(LocaleEN).Shows.Display(ctx, params)
+	assert.NoError(t, err)
+	_, err = bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(5), atomic.LoadInt32(requests))
+
+	_, err = bc.WithToken("token").Shows.Display(ctx, params)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(5), atomic.LoadInt32(requests))
+}
+
+func TestCache_ETag(t *testing.T) {
+	ts, bc, requests := setupCache(t, CacheTTLs{"/shows/display": time.Nanosecond})
+	defer ts.Close()
+
+	for i := 0; i < 3; i++ {
+		show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+		assert.Equal(t, "request 1", show.Title)
+	}
+
+	// The expired entry is revalidated on each call.
+	assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+}
+
+func TestCache_NotCached(t *testing.T) {
+	ts, bc, requests := setupCache(t, CacheTTLs{"/shows/seasons": time.Hour})
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+		_, err = bc.Shows.Add(context.Background(), ShowsAddParams{ID: Int(1161)})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(4), atomic.LoadInt32(requests))
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	ts, bc, requests := setupCache(t, nil)
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name   string
+		mutate func() error
+	}{
+		{name: "add", mutate: func() error {
+			_, err := bc.Shows.Add(ctx, ShowsAddParams{ID: Int(1161)})
+			return err
+		}},
+		{name: "delete", mutate: func() error {
+			_, err := bc.Shows.Delete(ctx, ShowsDeleteParams{ID: Int(1161)})
+			return err
+		}},
+		{name: "archive", mutate: func() error {
+			_, err := bc.Shows.Archive(ctx, ShowsArchiveParams{ID: Int(1161)})
+			return err
+		}},
+		{name: "add note", mutate: func() error {
+			_, err := bc.Shows.AddNote(ctx, ShowsAddNoteParams{ID: Int(1161), Note: 5})
+			return err
+		}},
+		// The show of an episode is only known from the response.
+		{name: "watched episode", mutate: func() error {
+			_, err := bc.Episodes.Watched(ctx, EpisodesWatchedParams{ID: Int(253454)})
+			return err
+		}},
+		{name: "unwatched episode", mutate: func() error {
+			_, err := bc.Episodes.Unwatched(ctx, EpisodesUnwatchedParams{ID: Int(253454)})
+			return err
+		}},
+		{name: "hidden episode", mutate: func() error {
+			_, err := bc.Episodes.Hide(ctx, EpisodesHiddenParams{ID: Int(253454)})
+			return err
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1161)})
+			assert.NoError(t, err)
+			_, err = bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1)})
+			assert.NoError(t, err)
+
+			before := atomic.LoadInt32(requests)
+			assert.NoError(t, tc.mutate())
+
+			_, err = bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1161)})
+			assert.NoError(t, err)
+			_, err = bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1)})
+			assert.NoError(t, err)
+
+			// The mutation and the display of the invalidated show.
+			assert.Equal(t, before+2, atomic.LoadInt32(requests))
+		})
+	}
+}
+
+func TestCache_InvalidateTheTvdbID(t *testing.T) {
+	ts, bc, requests := setupCache(t, nil)
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	display := func() {
+		_, err := bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+		_, err = bc.Shows.Display(ctx, ShowsDisplayParams{TheTvdbID: Int(121361)})
+		assert.NoError(t, err)
+	}
+
+	display()
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+
+	// The show given by its TheTVDB id is invalidated whatever the id it was
+	// displayed with, and the other way around.
+	_, err := bc.Shows.Add(ctx, ShowsAddParams{TheTvdbID: Int(121361)})
+	assert.NoError(t, err)
+	display()
+	assert.Equal(t, int32(5), atomic.LoadInt32(requests))
+
+	_, err = bc.Shows.Archive(ctx, ShowsArchiveParams{ID: Int(1161)})
+	assert.NoError(t, err)
+	display()
+	assert.Equal(t, int32(8), atomic.LoadInt32(requests))
+}
+
+func TestCache_Recommendation(t *testing.T) {
+	ts, bc, requests := setupCache(t, nil)
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	_, err := bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(5)})
+	assert.NoError(t, err)
+
+	// The id of a recommendation is not the one of a show.
+	_, err = bc.Shows.DeleteRecommendation(ctx, ShowsDeleteRecommendationParams{ID: 5})
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(5)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestCache_Middleware(t *testing.T) {
+	// The dump middleware replaces the body of the responses.
+	ts, bc, requests := setupCache(t, nil, WithMiddleware(DumpMiddleware(io.Discard)))
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+		assert.Equal(t, 1161, show.ID)
+		assert.Equal(t, "request 1", show.Title)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestCache_CaptureResponse(t *testing.T) {
+	ts, bc, _ := setupCache(t, nil)
+	defer ts.Close()
+
+	var res Response
+	for i := 0; i < 2; i++ {
+		_, err := bc.Shows.Display(CaptureResponse(context.Background(), &res), ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, Response{StatusCode: http.StatusOK, Cached: true}, res)
+}
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", &CacheEntry{Body: []byte("a"), Tags: []string{"show:1"}})
+	c.Set("b", &CacheEntry{Body: []byte("b"), Tags: []string{"show:2"}})
+
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	c.Set("c", &CacheEntry{Body: []byte("c"), Tags: []string{"show:1"}})
+
+	_, ok = c.Get("b")
+	assert.False(t, ok, "least recently used entry evicted")
+	assert.Equal(t, 2, c.Len())
+
+	c.Invalidate("show:1")
+	assert.Equal(t, 0, c.Len())
+
+	c.Set("a", &CacheEntry{Body: []byte("a1")})
+	c.Set("a", &CacheEntry{Body: []byte("a2")})
+	entry, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "a2", string(entry.Body))
+	assert.Equal(t, 1, c.Len())
+}
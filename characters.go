@@ -12,3 +12,17 @@ type CharacterShow struct {
 	Actor    string `json:"actor"`
 	Picture  string `json:"picture"`
 }
+
+type charactersMovieResponse struct {
+	Characters []CharacterMovie `json:"characters"`
+	Errors     Errors           `json:"errors"`
+}
+
+type CharacterMovie struct {
+	ID       int    `json:"id"`
+	MovieID  int    `json:"movie_id"`
+	PersonID int    `json:"person_id"`
+	Name     string `json:"name"`
+	Actor    string `json:"actor"`
+	Picture  string `json:"picture"`
+}
@@ -0,0 +1,125 @@
+// Command gotaseries-cache inspects and purges the on-disk cache of gotaseries
+// clients created with filecache.
+//
+// Usage:
+//
+//	gotaseries-cache [-dir dir] list
+//	gotaseries-cache [-dir dir] purge [-expired] [-tag tag]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/florentsorel/gotaseries/filecache"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "gotaseries-cache:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("gotaseries-cache", flag.ContinueOnError)
+	fs.SetOutput(w)
+	dir := fs.String("dir", filecache.DefaultDir(), "cache directory")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gotaseries-cache [-dir dir] list | purge [-expired] [-tag tag]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("missing command")
+	}
+
+	cache, err := filecache.New(*dir, 0)
+	if err != nil {
+		return err
+	}
+
+	switch cmd := fs.Arg(0); cmd {
+	case "list":
+		return list(cache, w)
+	case "purge":
+		return purge(cache, fs.Args()[1:], w)
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+func list(cache *filecache.Cache, w io.Writer) error {
+	entries, err := cache.Entries()
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tSIZE\tEXPIRES\tTAGS")
+
+	var size int64
+	for _, e := range entries {
+		expires := e.Expires.Format(time.RFC3339)
+		if e.Expired() {
+			expires += " (expired)"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", e.Key, e.Size, expires, strings.Join(e.Tags, ","))
+		size += e.Size
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%d entries, %d bytes in %s\n", len(entries), size, cache.Dir())
+	return err
+}
+
+func purge(cache *filecache.Cache, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("purge", flag.ContinueOnError)
+	fs.SetOutput(w)
+	expired := fs.Bool("expired", false, "only purge the expired entries")
+	tag := fs.String("tag", "", "only purge the entries having the tag, e.g. show:1161")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	n, err := cache.Purge(func(e *filecache.Entry) bool {
+		if *expired && !e.Expired() {
+			return false
+		}
+		if *tag != "" && !hasTag(e, *tag) {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%d entries purged\n", n)
+	return err
+}
+
+func hasTag(e *filecache.Entry, tag string) bool {
+	for _, t := range e.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/florentsorel/gotaseries"
+	"github.com/florentsorel/gotaseries/filecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+
+	cache, err := filecache.New(dir, 0)
+	assert.NoError(t, err)
+
+	cache.Set("GET /shows/display?id=1161", &gotaseries.CacheEntry{
+		Body:    []byte(`{}`),
+		Expires: time.Now().Add(time.Hour),
+		Tags:    []string{"show:1161"},
+	})
+	cache.Set("GET /movies/genres?", &gotaseries.CacheEntry{
+		Body:    []byte(`{}`),
+		Expires: time.Now().Add(time.Hour),
+	})
+	// The expired entries are removed by the writes, this one expires after
+	// the last one.
+	cache.Set("GET /shows/display?id=1", &gotaseries.CacheEntry{
+		Body:    []byte(`{}`),
+		Expires: time.Now().Add(50 * time.Millisecond),
+		Tags:    []string{"show:1"},
+	})
+	time.Sleep(100 * time.Millisecond)
+
+	var out bytes.Buffer
+	assert.NoError(t, run([]string{"-dir", dir, "list"}, &out))
+	assert.Contains(t, out.String(), "GET /shows/display?id=1161")
+	assert.Contains(t, out.String(), "(expired)")
+	assert.Contains(t, out.String(), "3 entries")
+
+	out.Reset()
+	assert.NoError(t, run([]string{"-dir", dir, "purge", "-expired"}, &out))
+	assert.Equal(t, "1 entries purged\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, run([]string{"-dir", dir, "purge", "-tag", "show:1161"}, &out))
+	assert.Equal(t, "1 entries purged\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, run([]string{"-dir", dir, "purge"}, &out))
+	assert.Equal(t, "1 entries purged\n", out.String())
+
+	assert.Error(t, run([]string{"-dir", dir}, &out))
+	assert.Error(t, run([]string{"-dir", dir, "dump"}, &out))
+}
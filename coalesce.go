@@ -0,0 +1,130 @@
+package gotaseries
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// flightGroup coalesces the concurrent identical GET requests of a client and
+// of its copies returned by WithToken and WithLocale: the first caller starts
+// the HTTP call and the callers arriving while it is in flight share its result.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is an HTTP call shared by the callers waiting for it.
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+
+	response errorableResponse
+	body     []byte
+	meta     Response
+	err      error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+// do calls fn once for the concurrent requests having the same key and the
+// same type of response, and decodes the body it returns into the response of
+// each caller, so that the callers do not share the slices and the pointers of
+// their response.
+//
+// fn runs with a context detached from the one of the callers, holding its
+// values but not its cancellation. A caller whose context is done stops
+// waiting and returns the error of its context, the call being canceled once
+// no caller is waiting for it anymore.
+func (g *flightGroup) do(req *http.Request, key string, response errorableResponse, fn func(*http.Request, errorableResponse) ([]byte, error)) error {
+	ctx := req.Context()
+	key += " " + reflect.TypeOf(response).String()
+
+	g.mu.Lock()
+	f, ok := g.flights[key]
+	if !ok {
+		f = &flight{done: make(chan struct{}), response: newResponse(response)}
+		g.flights[key] = f
+
+		var fctx context.Context
+		fctx, f.cancel = context.WithCancel(detachedContext{ctx})
+		go g.run(key, f, req.WithContext(CaptureResponse(fctx, &f.meta)), fn)
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		g.leave(key, f)
+		return ctx.Err()
+	}
+
+	if meta := responseFromContext(ctx); meta != nil {
+		*meta = f.meta
+	}
+
+	if f.err != nil || len(bytes.TrimSpace(f.body)) == 0 {
+		return f.err
+	}
+
+	return json.Unmarshal(f.body, response)
+}
+
+func (g *flightGroup) run(key string, f *flight, req *http.Request, fn func(*http.Request, errorableResponse) ([]byte, error)) {
+	f.body, f.err = fn(req, f.response)
+
+	g.mu.Lock()
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	g.mu.Unlock()
+
+	f.cancel()
+	close(f.done)
+}
+
+// leave removes a caller which stopped waiting for the call, and cancels the
+// call when it was the last one.
+func (g *flightGroup) leave(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	f.waiters--
+	if f.waiters > 0 {
+		return
+	}
+
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	f.cancel()
+}
+
+// detachedContext holds the values of its parent but is never canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key any) any {
+	return c.parent.Value(key)
+}
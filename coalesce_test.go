@@ -0,0 +1,293 @@
+package gotaseries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupFlight returns a server answering the requests once release is closed.
+func setupFlight(t *testing.T, release chan struct{}) (*httptest.Server, *Client, *int32) {
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+
+		if r.URL.Query().Get("id") == "0" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"code": 4001, "text": "Show not found."}]}`))
+			return
+		}
+
+		w.Header().Set(headerRequestID, fmt.Sprint(n))
+		if r.URL.Path == "/shows/list" {
+			_, _ = fmt.Fprintf(w, `{"shows": [{"id": 1161, "title": "request %d"}]}`, n)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"show": {"id": %s, "title": "request %d"}}`, r.URL.Query().Get("id"), n)
+	}))
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	return ts, bc, &requests
+}
+
+// waitFlights waits for n callers to wait for the in-flight calls of the client.
+func waitFlights(t *testing.T, bc *Client, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		bc.flights.mu.Lock()
+		var waiters int
+		for _, f := range bc.flights.flights {
+			waiters += f.waiters
+		}
+		bc.flights.mu.Unlock()
+
+		if waiters == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("%d callers not waiting", n)
+}
+
+func TestCoalesce(t *testing.T) {
+	release := make(chan struct{})
+	ts, bc, requests := setupFlight(t, release)
+	defer ts.Close()
+
+	var wg sync.WaitGroup
+	shows := make([]*Show, 5)
+	for i := range shows {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+			assert.NoError(t, err)
+			shows[i] = show
+		}(i)
+	}
+
+	waitFlights(t, bc, 5)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+	for _, show := range shows {
+		assert.Equal(t, "request 1", show.Title)
+	}
+	// Each caller decodes its own copy of the response.
+	assert.NotSame(t, shows[0], shows[1])
+
+	// The calls are not shared once completed.
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestCoalesce_Isolated(t *testing.T) {
+	release := make(chan struct{})
+	ts, bc, requests := setupFlight(t, release)
+	defer ts.Close()
+
+	var wg sync.WaitGroup
+	lists := make([][]Show, 2)
+	for i := range lists {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			shows, err := bc.Shows.List(context.Background(), ShowsListParams{})
+			assert.NoError(t, err)
+			lists[i] = shows
+		}(i)
+	}
+
+	waitFlights(t, bc, 2)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+	// A caller modifying its result does not modify the one of the others.
+	lists[0][0].Title = "modified"
+	assert.Equal(t, "request 1", lists[1][0].Title)
+}
+
+func TestCoalesce_Middleware(t *testing.T) {
+	release := make(chan struct{})
+	ts, _, requests := setupFlight(t, release)
+	defer ts.Close()
+
+	// The dump middleware replaces the body of the responses.
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL), WithMiddleware(DumpMiddleware(io.Discard)))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+			assert.NoError(t, err)
+			assert.Equal(t, 1161, show.ID)
+			assert.Equal(t, "request 1", show.Title)
+		}()
+	}
+
+	waitFlights(t, bc, 3)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestCoalesce_Key(t *testing.T) {
+	release := make(chan struct{})
+	ts, bc, requests := setupFlight(t, release)
+	defer ts.Close()
+
+	clients := []*Client{
+		bc,
+		bc,
+		bc.WithToken("token"),
+		bc.WithToken("token"),
+		bc.WithToken("other"),
+		bc.WithLocale(LocaleEN),
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+			_, err := c.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+			assert.NoError(t, err)
+		}(c)
+	}
+
+	waitFlights(t, bc, len(clients))
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(4), atomic.LoadInt32(requests))
+}
+
+func TestCoalesce_Error(t *testing.T) {
+	release := make(chan struct{})
+	ts, bc, requests := setupFlight(t, release)
+	defer ts.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var res Response
+			_, err := bc.Shows.Display(CaptureResponse(context.Background(), &res), ShowsDisplayParams{ID: Int(0)})
+
+			var apiErrs APIErrors
+			assert.True(t, errors.As(err, &apiErrs))
+			assert.Equal(t, 4001, apiErrs[0].Code)
+			assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		}()
+	}
+
+	waitFlights(t, bc, 3)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestCoalesce_Cancel(t *testing.T) {
+	release := make(chan struct{})
+	ts, bc, requests := setupFlight(t, release)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	canceled := make(chan error)
+	go func() {
+		_, err := bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1161)})
+		canceled <- err
+	}()
+
+	var res Response
+	done := make(chan *Show)
+	go func() {
+		show, err := bc.Shows.Display(CaptureResponse(context.Background(), &res), ShowsDisplayParams{ID: Int(1161)})
+		assert.NoError(t, err)
+		done <- show
+	}()
+
+	waitFlights(t, bc, 2)
+
+	// The canceled caller returns while the call goes on for the other one.
+	cancel()
+	assert.ErrorIs(t, <-canceled, context.Canceled)
+
+	close(release)
+	show := <-done
+
+	assert.Equal(t, "request 1", show.Title)
+	assert.Equal(t, "1", res.RequestID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestCoalesce_CancelAll(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	var canceled int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			atomic.AddInt32(&canceled, 1)
+		}
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1161)})
+			assert.ErrorIs(t, err, context.Canceled)
+		}()
+	}
+
+	waitFlights(t, bc, 2)
+	cancel()
+	wg.Wait()
+
+	// The call is canceled once no caller waits for it.
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&canceled) == 1
+	}, time.Second, time.Millisecond)
+}
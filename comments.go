@@ -0,0 +1,237 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	CommentTypeEpisode CommentType = "episode"
+	CommentTypeShow    CommentType = "show"
+	CommentTypeMovie   CommentType = "movie"
+	CommentTypeMember  CommentType = "member"
+
+	OrderCommentASC  OrderCommentType = "asc"
+	OrderCommentDESC OrderCommentType = "desc"
+
+	ThumbUp   ThumbType = 1
+	ThumbDown ThumbType = -1
+)
+
+type CommentService Service
+
+type CommentType string
+
+type OrderCommentType string
+
+type ThumbType int
+
+type commentsResponse struct {
+	Comments []Comment `json:"comments"`
+	Errors   Errors    `json:"errors"`
+}
+
+type commentResponse struct {
+	Comment Comment `json:"comment"`
+	Errors  Errors  `json:"errors"`
+}
+
+type Comment struct {
+	ID             int         `json:"id"`
+	Ref            string      `json:"ref"`
+	RefID          int         `json:"ref_id"`
+	Type           CommentType `json:"type"`
+	UserID         int         `json:"user_id"`
+	Login          string      `json:"login"`
+	Avatar         *string     `json:"avatar"`
+	Date           DateTime    `json:"date"`
+	Text           string      `json:"text"`
+	InnerID        int         `json:"inner_id"`
+	InReplyTo      int         `json:"in_reply_to"`
+	InReplyToLogin *string     `json:"in_reply_to_login"`
+	UserXP         int         `json:"user_xp"`
+	Thumbs         int         `json:"thumbs"`
+	Thumbed        ThumbType   `json:"thumbed"`
+	NbReplies      int         `json:"nbrep"`
+	Replies        []Comment   `json:"replies"`
+}
+
+type CommentsCommentsParams struct {
+	Type    CommentType       `url:"type"`
+	ID      int               `url:"id"`
+	PerPage *int              `url:"nbpp"`
+	SinceID *int              `url:"since_id"`
+	Order   *OrderCommentType `url:"order"`
+	Replies *bool             `url:"replies"`
+	Locale  *LocaleType       `url:"locale"`
+}
+
+type CommentsCommentParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type CommentsPostParams struct {
+	Type      CommentType `url:"type"`
+	ID        int         `url:"id"`
+	Text      string      `url:"text"`
+	InReplyTo *int        `url:"in_reply_to"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type CommentsDeleteParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type CommentsThumbParams struct {
+	ID     int         `url:"id"`
+	Type   ThumbType   `url:"type"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type CommentsDeleteThumbParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type CommentsSubscriptionParams struct {
+	Type   CommentType `url:"type"`
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type CommentsStatusParams struct {
+	Type   CommentType `url:"type"`
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type CommentsRepliesParams struct {
+	ID     int               `url:"id"`
+	Order  *OrderCommentType `url:"order"`
+	Locale *LocaleType       `url:"locale"`
+}
+
+// NextPage returns the params to fetch the comments following the given page, using
+// the ID of its last comment as cursor. It returns false when there is no next page.
+//
+// Example:
+//
+//	params := gotaseries.CommentsCommentsParams{Type: gotaseries.CommentTypeShow, ID: 1161, PerPage: gotaseries.Int(50)}
+//	for {
+//		comments, err := client.Comments.Comments(ctx, params)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		// ...
+//		var ok bool
+//		if params, ok = params.NextPage(comments); !ok {
+//			break
+//		}
+//	}
+func (p CommentsCommentsParams) NextPage(comments []Comment) (CommentsCommentsParams, bool) {
+	if len(comments) == 0 {
+		return p, false
+	}
+
+	if p.PerPage != nil && len(comments) < *p.PerPage {
+		return p, false
+	}
+
+	p.SinceID = Int(comments[len(comments)-1].ID)
+
+	return p, true
+}
+
+// Comments returns the comments of an episode, a series, a movie or a member.
+func (c *CommentService) Comments(ctx context.Context, params CommentsCommentsParams) ([]Comment, error) {
+	var res commentsResponse
+	if err := c.client.doRequest(ctx, http.MethodGet, "/comments/comments", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Comments, nil
+}
+
+// Comment returns a comment.
+func (c *CommentService) Comment(ctx context.Context, params CommentsCommentParams) (*Comment, error) {
+	var res commentResponse
+	if err := c.client.doRequest(ctx, http.MethodGet, "/comments/comment", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Comment, nil
+}
+
+// Post post a comment, or a reply to a comment when InReplyTo is set.
+// Require a valid token.
+func (c *CommentService) Post(ctx context.Context, params CommentsPostParams) (*Comment, error) {
+	var res commentResponse
+	if err := c.client.doRequest(ctx, http.MethodPost, "/comments/comment", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Comment, nil
+}
+
+// Delete delete a comment of the authenticated member.
+// Require a valid token.
+func (c *CommentService) Delete(ctx context.Context, params CommentsDeleteParams) error {
+	var res emptyResponse
+	return c.client.doRequest(ctx, http.MethodDelete, "/comments/comment", params, &res)
+}
+
+// Thumb vote for a comment.
+// Require a valid token.
+func (c *CommentService) Thumb(ctx context.Context, params CommentsThumbParams) (*Comment, error) {
+	var res commentResponse
+	if err := c.client.doRequest(ctx, http.MethodPost, "/comments/thumb", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Comment, nil
+}
+
+// DeleteThumb remove the vote of the authenticated member for a comment.
+// Require a valid token.
+func (c *CommentService) DeleteThumb(ctx context.Context, params CommentsDeleteThumbParams) (*Comment, error) {
+	var res commentResponse
+	if err := c.client.doRequest(ctx, http.MethodDelete, "/comments/thumb", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Comment, nil
+}
+
+// Subscribe subscribe the authenticated member to email notifications for the comments of an item.
+// Require a valid token.
+func (c *CommentService) Subscribe(ctx context.Context, params CommentsSubscriptionParams) error {
+	var res emptyResponse
+	return c.client.doRequest(ctx, http.MethodPost, "/comments/subscription", params, &res)
+}
+
+// Unsubscribe unsubscribe the authenticated member from email notifications for the comments of an item.
+// Require a valid token.
+func (c *CommentService) Unsubscribe(ctx context.Context, params CommentsSubscriptionParams) error {
+	var res emptyResponse
+	return c.client.doRequest(ctx, http.MethodDelete, "/comments/subscription", params, &res)
+}
+
+// Close close the comments of an item.
+// Require a valid token.
+func (c *CommentService) Close(ctx context.Context, params CommentsStatusParams) error {
+	var res emptyResponse
+	return c.client.doRequest(ctx, http.MethodPost, "/comments/close", params, &res)
+}
+
+// Open open again the comments of an item.
+// Require a valid token.
+func (c *CommentService) Open(ctx context.Context, params CommentsStatusParams) error {
+	var res emptyResponse
+	return c.client.doRequest(ctx, http.MethodPost, "/comments/open", params, &res)
+}
+
+// Replies returns the replies of a comment.
+func (c *CommentService) Replies(ctx context.Context, params CommentsRepliesParams) ([]Comment, error) {
+	var res commentsResponse
+	if err := c.client.doRequest(ctx, http.MethodGet, "/comments/replies", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Comments, nil
+}
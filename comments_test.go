@@ -0,0 +1,167 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentService_Comments(t *testing.T) {
+	data, err := os.ReadFile("data/comments/comments.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "comments/comments?id=1161&nbpp=2&replies=true&type=show"), string(data))
+	defer ts.Close()
+
+	comments, err := bc.Comments.Comments(context.Background(), CommentsCommentsParams{
+		Type:    CommentTypeShow,
+		ID:      1161,
+		PerPage: Int(2),
+		Replies: Bool(true),
+	})
+	assert.NoError(t, err)
+
+	d, err := time.Parse("2006-01-02 15:04:05", "2019-05-20 08:12:44")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(comments))
+	assert.Equal(t, "test user", comments[0].Login)
+	assert.Equal(t, DateTime(d), comments[0].Date)
+	assert.Equal(t, 14, comments[0].Thumbs)
+	assert.Equal(t, 1, comments[0].NbReplies)
+	assert.Equal(t, 1, len(comments[0].Replies))
+	assert.Equal(t, "Dev051", comments[0].Replies[0].Login)
+	assert.Equal(t, "test user", *comments[0].Replies[0].InReplyToLogin)
+	assert.Equal(t, ThumbUp, comments[0].Replies[0].Thumbed)
+	assert.Equal(t, ThumbDown, comments[1].Thumbed)
+}
+
+func TestCommentsCommentsParams_NextPage(t *testing.T) {
+	params := CommentsCommentsParams{
+		Type:    CommentTypeShow,
+		ID:      1161,
+		PerPage: Int(2),
+	}
+
+	next, ok := params.NextPage([]Comment{{ID: 10}, {ID: 12}})
+	assert.True(t, ok)
+	assert.Equal(t, 12, *next.SinceID)
+	assert.Nil(t, params.SinceID)
+
+	_, ok = next.NextPage([]Comment{{ID: 14}})
+	assert.False(t, ok)
+
+	_, ok = next.NextPage(nil)
+	assert.False(t, ok)
+}
+
+func TestCommentService_Comment(t *testing.T) {
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "comments/comment?id=6015432"), `{"comment": {"id": 6015432, "text": "Quelle fin..."}}`)
+	defer ts.Close()
+
+	comment, err := bc.Comments.Comment(context.Background(), CommentsCommentParams{
+		ID: 6015432,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Quelle fin...", comment.Text)
+}
+
+func TestCommentService_Post(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "comments/comment?id=1161&in_reply_to=1&text=Bien+vu&type=show"), `{"comment": {"id": 6015600, "text": "Bien vu", "in_reply_to": 1}}`)
+	defer ts.Close()
+
+	comment, err := bc.Comments.Post(context.Background(), CommentsPostParams{
+		Type:      CommentTypeShow,
+		ID:        1161,
+		Text:      "Bien vu",
+		InReplyTo: Int(1),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 6015600, comment.ID)
+	assert.Equal(t, 1, comment.InReplyTo)
+}
+
+func TestCommentService_Delete(t *testing.T) {
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "comments/comment?id=6015600"), `{"errors": []}`)
+	defer ts.Close()
+
+	err := bc.Comments.Delete(context.Background(), CommentsDeleteParams{
+		ID: 6015600,
+	})
+	assert.NoError(t, err)
+}
+
+func TestCommentService_Thumb(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "comments/thumb?id=6015432&type=-1"), `{"comment": {"id": 6015432, "thumbs": 13, "thumbed": -1}}`)
+	defer ts.Close()
+
+	comment, err := bc.Comments.Thumb(context.Background(), CommentsThumbParams{
+		ID:   6015432,
+		Type: ThumbDown,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 13, comment.Thumbs)
+	assert.Equal(t, ThumbDown, comment.Thumbed)
+}
+
+func TestCommentService_Subscription(t *testing.T) {
+	testCases := []struct {
+		method string
+	}{
+		{method: "POST"},
+		{method: "DELETE"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			ts, bc := setup(t, tc.method, fmt.Sprintf("/%s", "comments/subscription?id=1161&type=show"), `{"errors": []}`)
+			defer ts.Close()
+
+			params := CommentsSubscriptionParams{Type: CommentTypeShow, ID: 1161}
+
+			var err error
+			if tc.method == "POST" {
+				err = bc.Comments.Subscribe(context.Background(), params)
+			} else {
+				err = bc.Comments.Unsubscribe(context.Background(), params)
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommentService_Close(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "comments/close?id=281009&type=episode"), `{"errors": [{"code": 2001, "text": "Invalid token."}]}`)
+	defer ts.Close()
+
+	err := bc.Comments.Close(context.Background(), CommentsStatusParams{
+		Type: CommentTypeEpisode,
+		ID:   281009,
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, err.Error(), "Code: 2001, Message: Invalid token.\n")
+}
+
+func TestCommentService_Replies(t *testing.T) {
+	data, err := os.ReadFile("data/comments/comments.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "comments/replies?id=6015432&order=asc"), string(data))
+	defer ts.Close()
+
+	comments, err := bc.Comments.Replies(context.Background(), CommentsRepliesParams{
+		ID:    6015432,
+		Order: OrderComment(OrderCommentASC),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(comments))
+}
@@ -1,10 +1,27 @@
 package gotaseries
 
+import (
+	"context"
+	"net/http"
+)
+
+type EpisodeService Service
+
 type episodesResponse struct {
 	Episodes []Episode `json:"episodes"`
 	Errors   Errors    `json:"errors"`
 }
 
+type episodeResponse struct {
+	Episode Episode `json:"episode"`
+	Errors  Errors  `json:"errors"`
+}
+
+type unseenEpisodesResponse struct {
+	Shows  []UnseenShow `json:"shows"`
+	Errors Errors       `json:"errors"`
+}
+
 type PlatformLinks struct {
 	PlatformID int     `json:"platform_id,string"`
 	Platform   string  `json:"platform"`
@@ -33,7 +50,13 @@ type Episode struct {
 	Note        Note        `json:"note"`
 	Date        Date        `json:"date"`
 	SeenTotal   int         `json:"seen_total"`
-	Show        struct {
+	User        struct {
+		Seen       bool      `json:"seen"`
+		SeenDate   *DateTime `json:"seen_date"`
+		Hidden     bool      `json:"hidden"`
+		Downloaded bool      `json:"downloaded"`
+	} `json:"user"`
+	Show struct {
 		ID          int    `json:"id"`
 		TheTvdbID   int    `json:"thetvdb_id"`
 		Title       string `json:"title"`
@@ -45,3 +68,257 @@ type Episode struct {
 	PlaformLinks []PlatformLinks `json:"platform_links"`
 	Subtitles    []Subtitle      `json:"subtitles"`
 }
+
+// UnseenShow is a series of the member's account along with its episodes not yet watched.
+type UnseenShow struct {
+	ID        int       `json:"id"`
+	TheTvdbID int       `json:"thetvdb_id"`
+	Title     string    `json:"title"`
+	Remaining int       `json:"remaining"`
+	Unseen    []Episode `json:"unseen"`
+}
+
+type EpisodesDisplayParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Subtitles *bool       `url:"subtitles"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesListParams struct {
+	ShowID        *int        `url:"showId"`
+	ShowTheTvdbID *int        `url:"showTheTvdbId"`
+	UserID        *int        `url:"userId"`
+	Limit         *int        `url:"limit"`
+	Released      *bool       `url:"released"`
+	Specials      *bool       `url:"specials"`
+	Subtitles     *string     `url:"subtitles"`
+	Locale        *LocaleType `url:"locale"`
+}
+
+type EpisodesNextParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Subtitles *bool       `url:"subtitles"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesLatestParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Subtitles *bool       `url:"subtitles"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesSearchParams struct {
+	ShowID    int         `url:"show_id"`
+	Number    string      `url:"number"`
+	Subtitles *bool       `url:"subtitles"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesScraperParams struct {
+	File   string      `url:"file"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type EpisodesUnratedParams struct {
+	PerPage *int        `url:"nbpp"`
+	Page    *int        `url:"page"`
+	Locale  *LocaleType `url:"locale"`
+}
+
+type EpisodesWatchedParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Bulk      *bool       `url:"bulk"`
+	Delete    *bool       `url:"delete"`
+	Note      *int        `url:"note"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesUnwatchedParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesDownloadedParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesAddNoteParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Note      int         `url:"note"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesDeleteNoteParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+type EpisodesHiddenParams struct {
+	ID        *int        `url:"id"`
+	TheTvdbID *int        `url:"thetvdb_id"`
+	Locale    *LocaleType `url:"locale"`
+}
+
+// Display returns information about an episode.
+func (e *EpisodeService) Display(ctx context.Context, params EpisodesDisplayParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/display", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// List returns the list of unseen episodes for the authenticated member or ID member, grouped by series.
+func (e *EpisodeService) List(ctx context.Context, params EpisodesListParams) ([]UnseenShow, error) {
+	var res unseenEpisodesResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/list", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Shows, nil
+}
+
+// Next returns the next episode to watch for a series.
+// Require a valid token.
+func (e *EpisodeService) Next(ctx context.Context, params EpisodesNextParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/next", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Latest returns the last aired episode of a series.
+func (e *EpisodeService) Latest(ctx context.Context, params EpisodesLatestParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/latest", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Search returns an episode of a series from its number (e.g. S01E01).
+func (e *EpisodeService) Search(ctx context.Context, params EpisodesSearchParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/search", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Scraper returns the episode matching a file name.
+func (e *EpisodeService) Scraper(ctx context.Context, params EpisodesScraperParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/scraper", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Unrated returns the list of watched and unrated episodes for the authenticated member.
+// Require a valid token.
+func (e *EpisodeService) Unrated(ctx context.Context, params EpisodesUnratedParams) ([]Episode, error) {
+	var res episodesResponse
+	if err := e.client.doRequest(ctx, http.MethodGet, "/episodes/unrated", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Episodes, nil
+}
+
+// UnratedPager returns a pager over the watched and unrated episodes, see Unrated.
+func (e *EpisodeService) UnratedPager(params EpisodesUnratedParams) *Pager[Episode] {
+	return newPagePager(func(ctx context.Context, page, perPage int) ([]Episode, int, error) {
+		p := params
+		p.Page, p.PerPage = Int(page), Int(perPage)
+		items, err := e.Unrated(ctx, p)
+		return items, 0, err
+	}, params.Page, params.PerPage)
+}
+
+// Watched mark an episode as seen.
+// Require a valid token.
+func (e *EpisodeService) Watched(ctx context.Context, params EpisodesWatchedParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodPost, "/episodes/watched", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Unwatched remove the seen mark of an episode.
+// Require a valid token.
+func (e *EpisodeService) Unwatched(ctx context.Context, params EpisodesUnwatchedParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodDelete, "/episodes/watched", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Downloaded mark an episode as downloaded.
+// Require a valid token.
+func (e *EpisodeService) Downloaded(ctx context.Context, params EpisodesDownloadedParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodPost, "/episodes/downloaded", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// DeleteDownloaded remove the downloaded mark of an episode.
+// Require a valid token.
+func (e *EpisodeService) DeleteDownloaded(ctx context.Context, params EpisodesDownloadedParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodDelete, "/episodes/downloaded", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// AddNote rate an episode.
+// Require a valid token.
+func (e *EpisodeService) AddNote(ctx context.Context, params EpisodesAddNoteParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodPost, "/episodes/note", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// DeleteNote delete an episode rating.
+// Require a valid token.
+func (e *EpisodeService) DeleteNote(ctx context.Context, params EpisodesDeleteNoteParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodDelete, "/episodes/note", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Hide hide an episode from the member's list of episodes to watch.
+// Require a valid token.
+func (e *EpisodeService) Hide(ctx context.Context, params EpisodesHiddenParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodPost, "/episodes/hidden", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
+
+// Unhide restore a hidden episode in the member's list of episodes to watch.
+// Require a valid token.
+func (e *EpisodeService) Unhide(ctx context.Context, params EpisodesHiddenParams) (*Episode, error) {
+	var res episodeResponse
+	if err := e.client.doRequest(ctx, http.MethodDelete, "/episodes/hidden", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Episode, nil
+}
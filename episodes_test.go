@@ -0,0 +1,177 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpisodeService_Display(t *testing.T) {
+	data, err := os.ReadFile("data/episodes/display.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "episodes/display?id=281009"), string(data))
+	defer ts.Close()
+
+	episode, err := bc.Episodes.Display(context.Background(), EpisodesDisplayParams{
+		ID: Int(281009),
+	})
+	assert.NoError(t, err)
+
+	seenDT, err := time.Parse("2006-01-02 15:04:05", "2019-05-20 21:14:02")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 281009, episode.ID)
+	assert.Equal(t, "Winter Is Coming", episode.Title)
+	assert.Equal(t, "S01E01", episode.Code)
+	assert.Equal(t, 1161, episode.Show.ID)
+	assert.Equal(t, true, episode.User.Seen)
+	assert.Equal(t, DateTime(seenDT), *episode.User.SeenDate)
+}
+
+func TestEpisodeService_DisplayNotFound(t *testing.T) {
+	data, err := os.ReadFile("data/episodes/not_found.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "episodes/display?id=1"), string(data))
+	defer ts.Close()
+
+	_, err = bc.Episodes.Display(context.Background(), EpisodesDisplayParams{
+		ID: Int(1),
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, err.Error(), "Code: 4002, Message: Episode not found.\n")
+}
+
+func TestEpisodeService_List(t *testing.T) {
+	data, err := os.ReadFile("data/episodes/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "episodes/list?limit=2&showId=1161"), string(data))
+	defer ts.Close()
+
+	shows, err := bc.Episodes.List(context.Background(), EpisodesListParams{
+		ShowID: Int(1161),
+		Limit:  Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(shows))
+	assert.Equal(t, "Game of Thrones", shows[0].Title)
+	assert.Equal(t, 2, shows[0].Remaining)
+	assert.Equal(t, 2, len(shows[0].Unseen))
+	assert.Equal(t, "S08E05", shows[0].Unseen[0].Code)
+	assert.Equal(t, true, shows[0].Unseen[0].User.Downloaded)
+	assert.Nil(t, shows[0].Unseen[0].User.SeenDate)
+}
+
+func TestEpisodeService_Search(t *testing.T) {
+	data, err := os.ReadFile("data/episodes/display.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "episodes/search?number=S01E01&show_id=1161"), string(data))
+	defer ts.Close()
+
+	episode, err := bc.Episodes.Search(context.Background(), EpisodesSearchParams{
+		ShowID: 1161,
+		Number: "S01E01",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 281009, episode.ID)
+}
+
+func TestEpisodeService_Watched(t *testing.T) {
+	data := `{"episode": {"id": 281009, "user": {"seen": true}}}`
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "episodes/watched?bulk=false&id=281009"), data)
+	defer ts.Close()
+
+	episode, err := bc.Episodes.Watched(context.Background(), EpisodesWatchedParams{
+		ID:   Int(281009),
+		Bulk: Bool(false),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, episode.User.Seen)
+}
+
+func TestEpisodeService_Unwatched(t *testing.T) {
+	data := `{"episode": {"id": 281009, "user": {"seen": false}}}`
+
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "episodes/watched?id=281009"), data)
+	defer ts.Close()
+
+	episode, err := bc.Episodes.Unwatched(context.Background(), EpisodesUnwatchedParams{
+		ID: Int(281009),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, false, episode.User.Seen)
+}
+
+func TestEpisodeService_Downloaded(t *testing.T) {
+	testCases := []struct {
+		method   string
+		expected bool
+	}{
+		{method: "POST", expected: true},
+		{method: "DELETE", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			data := fmt.Sprintf(`{"episode": {"id": 281009, "user": {"downloaded": %t}}}`, tc.expected)
+
+			ts, bc := setup(t, tc.method, fmt.Sprintf("/%s", "episodes/downloaded?id=281009"), data)
+			defer ts.Close()
+
+			params := EpisodesDownloadedParams{ID: Int(281009)}
+
+			var episode *Episode
+			var err error
+			if tc.method == "POST" {
+				episode, err = bc.Episodes.Downloaded(context.Background(), params)
+			} else {
+				episode, err = bc.Episodes.DeleteDownloaded(context.Background(), params)
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.expected, episode.User.Downloaded)
+		})
+	}
+}
+
+func TestEpisodeService_Note(t *testing.T) {
+	data := `{"episode": {"id": 281009, "note": {"total": 8500, "mean": 4.4327, "user": 5}}}`
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "episodes/note?id=281009&note=5"), data)
+	defer ts.Close()
+
+	episode, err := bc.Episodes.AddNote(context.Background(), EpisodesAddNoteParams{
+		ID:   Int(281009),
+		Note: 5,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5, *episode.Note.User)
+}
+
+func TestEpisodeService_Hide(t *testing.T) {
+	data := `{"episode": {"id": 281009, "user": {"hidden": true}}}`
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "episodes/hidden?id=281009"), data)
+	defer ts.Close()
+
+	episode, err := bc.Episodes.Hide(context.Background(), EpisodesHiddenParams{
+		ID: Int(281009),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, episode.User.Hidden)
+}
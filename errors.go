@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type errorableResponse interface {
@@ -30,18 +34,38 @@ func (v *videosShowResponse) GetErrors() Errors {
 	return v.Errors
 }
 
+func (v *videosMovieResponse) GetErrors() Errors {
+	return v.Errors
+}
+
 func (c *charactersShowResponse) GetErrors() Errors {
 	return c.Errors
 }
 
+func (c *charactersMovieResponse) GetErrors() Errors {
+	return c.Errors
+}
+
 func (e *episodesResponse) GetErrors() Errors {
 	return e.Errors
 }
 
+func (e *episodeResponse) GetErrors() Errors {
+	return e.Errors
+}
+
+func (u *unseenEpisodesResponse) GetErrors() Errors {
+	return u.Errors
+}
+
 func (s *similarsResponse) GetErrors() Errors {
 	return s.Errors
 }
 
+func (s *similarsMovieResponse) GetErrors() Errors {
+	return s.Errors
+}
+
 func (r *recommendationsResponse) GetErrors() Errors {
 	return r.Errors
 }
@@ -62,6 +86,78 @@ func (s *seasonsResponse) GetErrors() Errors {
 	return s.Errors
 }
 
+func (m *moviesResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (m *movieResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (m *MoviesMemberResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (m *memberResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (m *MembersAuthResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (o *optionsResponse) GetErrors() Errors {
+	return o.Errors
+}
+
+func (n *notificationsResponse) GetErrors() Errors {
+	return n.Errors
+}
+
+func (m *MembersUsernameResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (e *emailResponse) GetErrors() Errors {
+	return e.Errors
+}
+
+func (a *avatarResponse) GetErrors() Errors {
+	return a.Errors
+}
+
+func (e *emptyResponse) GetErrors() Errors {
+	return e.Errors
+}
+
+func (c *calendarResponse) GetErrors() Errors {
+	return c.Errors
+}
+
+func (c *commentsResponse) GetErrors() Errors {
+	return c.Errors
+}
+
+func (c *commentResponse) GetErrors() Errors {
+	return c.Errors
+}
+
+func (u *usersResponse) GetErrors() Errors {
+	return u.Errors
+}
+
+func (f *friendResponse) GetErrors() Errors {
+	return f.Errors
+}
+
+func (m *messagesResponse) GetErrors() Errors {
+	return m.Errors
+}
+
+func (m *messageResponse) GetErrors() Errors {
+	return m.Errors
+}
+
 func (b *badgeResponse) GetErrors() Errors {
 	return b.Errors
 }
@@ -70,6 +166,240 @@ func (a *articlesResponse) GetErrors() Errors {
 	return a.Errors
 }
 
+// Sentinel errors matched by APIError with errors.Is.
+var (
+	ErrInvalidAPIKey      = errors.New("gotaseries: invalid API key")
+	ErrUnauthorized       = errors.New("gotaseries: unauthorized")
+	ErrAlreadyInAccount   = errors.New("gotaseries: already in account")
+	ErrNotInAccount       = errors.New("gotaseries: not in account")
+	ErrAlreadyRecommended = errors.New("gotaseries: already recommended")
+	ErrNotFriends         = errors.New("gotaseries: members are not friends")
+	ErrInvalidRequest     = errors.New("gotaseries: invalid request")
+	ErrNotFound           = errors.New("gotaseries: not found")
+)
+
+// codeErrors maps the Betaseries error codes to their sentinel error.
+var codeErrors = map[int]error{
+	1001: ErrInvalidAPIKey,
+	2001: ErrUnauthorized,
+	2003: ErrAlreadyInAccount,
+	2004: ErrNotInAccount,
+}
+
+// textErrors maps the messages of the errors Betaseries returns with code 0 to their sentinel error.
+var textErrors = []struct {
+	text string
+	err  error
+}{
+	{text: "ne sont pas amis", err: ErrNotFriends},
+	{text: "are not friends", err: ErrNotFriends},
+	{text: "déjà recommandé", err: ErrAlreadyRecommended},
+	{text: "already recommended", err: ErrAlreadyRecommended},
+	{text: "déjà cette série", err: ErrAlreadyInAccount},
+	{text: "You must send", err: ErrInvalidRequest},
+	{text: "Wrong value", err: ErrInvalidRequest},
+}
+
+// APIError is an error returned by the Betaseries API.
+//
+// Example:
+//
+//	_, err := client.Shows.Display(ctx, gotaseries.ShowsDisplayParams{ID: gotaseries.Int(1)})
+//	if errors.Is(err, gotaseries.ErrNotFound) {
+//		// ...
+//	}
+//	var apiErr *gotaseries.APIError
+//	if errors.As(err, &apiErr) {
+//		fmt.Println(apiErr.Code, apiErr.Path)
+//	}
+type APIError struct {
+	Code       int
+	Message    string
+	StatusCode int
+	Path       string
+	// RetryAfter is the delay requested by the server with the Retry-After header.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("Code: %d, Message: %s", e.Code, e.Message)
+}
+
+// Is reports whether the error matches target, either a sentinel error or an *APIError with the same code.
+func (e *APIError) Is(target error) bool {
+	if t, ok := target.(*APIError); ok {
+		return t.Code == e.Code
+	}
+
+	return target != nil && target == e.sentinel()
+}
+
+func (e *APIError) sentinel() error {
+	if err, ok := codeErrors[e.Code]; ok {
+		return err
+	}
+
+	if e.Code >= 3000 && e.Code < 4000 {
+		return ErrInvalidRequest
+	}
+
+	if e.Code >= 4000 && e.Code < 5000 {
+		return ErrNotFound
+	}
+
+	if e.Code == 0 {
+		for _, te := range textErrors {
+			if strings.Contains(e.Message, te.text) {
+				return te.err
+			}
+		}
+	}
+
+	return nil
+}
+
+// APIErrors holds all the errors of a Betaseries response.
+type APIErrors []*APIError
+
+func (errs APIErrors) Error() string {
+	b := bytes.NewBuffer(nil)
+	for _, e := range errs {
+		_, _ = fmt.Fprintf(b, "%s\n", e.Error())
+	}
+
+	return b.String()
+}
+
+// Is reports whether one of the errors matches target.
+func (errs APIErrors) Is(target error) bool {
+	for _, e := range errs {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error matching target.
+func (errs APIErrors) As(target any) bool {
+	for _, e := range errs {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the errors.
+func (errs APIErrors) Unwrap() []error {
+	res := make([]error, 0, len(errs))
+	for _, e := range errs {
+		res = append(res, e)
+	}
+	return res
+}
+
+// ErrResponseTooLarge is wrapped by the *HTTPError returned when a response body
+// exceeds MaxResponseSize.
+var ErrResponseTooLarge = errors.New("gotaseries: response body too large")
+
+// maxBodySnippet is the number of bytes of the body kept in an HTTPError.
+const maxBodySnippet = 512
+
+// HTTPError is returned when the server does not answer with a Betaseries
+// response, e.g. a 5xx status, an HTML error page or an unexpected empty body.
+// Errors reported by the API itself are returned as APIErrors.
+type HTTPError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Status     string
+	// Body holds the beginning of the response body.
+	Body []byte
+	// RetryAfter is the delay requested by the server with the Retry-After header.
+	RetryAfter time.Duration
+	Rate       Rate
+	// Err is the decoding error when the body is not valid JSON, or
+	// ErrResponseTooLarge.
+	Err error
+}
+
+// NewHTTPError returns the error of a response which is not a Betaseries
+// response, body being the body read and err the error decoding it, if any.
+// It is used by the packages sending their own requests, like oauth.
+func NewHTTPError(res *http.Response, body []byte, err error) *HTTPError {
+	return newHTTPError(res.Request, res, body, err)
+}
+
+func newHTTPError(req *http.Request, res *http.Response, body []byte, err error) *HTTPError {
+	return &HTTPError{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Body:       snippet(body),
+		RetryAfter: parseRetryAfter(res.Header, time.Now()),
+		Rate:       parseRate(res.Header),
+		Err:        err,
+	}
+}
+
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("gotaseries: %s %s: %s", e.Method, e.Path, e.Status)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	if len(e.Body) > 0 {
+		msg += fmt.Sprintf(": %q", e.Body)
+	}
+	return msg
+}
+
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// Temporary reports whether the request may succeed if retried later.
+func (e *HTTPError) Temporary() bool {
+	return temporaryStatus(e.StatusCode)
+}
+
+func temporaryStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// responseStatus returns the status code and the Retry-After delay of the
+// response of an error, either an *HTTPError or APIErrors, so that a 429 or a
+// 5xx status is handled the same way whether its body holds Betaseries errors
+// or not. ok is false when no response was received.
+func responseStatus(err error) (code int, retryAfter time.Duration, ok bool) {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode, httpErr.RetryAfter, true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode != 0 {
+		return apiErr.StatusCode, apiErr.RetryAfter, true
+	}
+
+	return 0, 0, false
+}
+
+func snippet(body []byte) []byte {
+	body = bytes.TrimSpace(body)
+	if len(body) <= maxBodySnippet {
+		return body
+	}
+
+	cut := maxBodySnippet
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+
+	return body[:cut]
+}
+
 type Error struct {
 	Code    int    `json:"code"`
 	Message string `json:"text"`
@@ -77,15 +407,34 @@ type Error struct {
 
 type Errors []Error
 
+// Err returns the errors as APIErrors, or nil if there is none.
 func (errs Errors) Err() error {
+	return errs.err(nil, "")
+}
+
+// err returns the errors of the response res, which can be nil.
+func (errs Errors) err(res *http.Response, path string) error {
 	if len(errs) == 0 {
 		return nil
 	}
 
-	b := bytes.NewBuffer(nil)
+	var statusCode int
+	var retryAfter time.Duration
+	if res != nil {
+		statusCode = res.StatusCode
+		retryAfter = parseRetryAfter(res.Header, time.Now())
+	}
+
+	apiErrs := make(APIErrors, 0, len(errs))
 	for _, e := range errs {
-		_, _ = fmt.Fprintf(b, "Code: %d, Message: %s\n", e.Code, e.Message)
+		apiErrs = append(apiErrs, &APIError{
+			Code:       e.Code,
+			Message:    e.Message,
+			StatusCode: statusCode,
+			Path:       path,
+			RetryAfter: retryAfter,
+		})
 	}
 
-	return errors.New(b.String())
+	return apiErrs
 }
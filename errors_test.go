@@ -0,0 +1,103 @@
+package gotaseries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      Error
+		expected error
+	}{
+		{name: "invalid api key", err: Error{Code: 1001, Message: "Invalid API key."}, expected: ErrInvalidAPIKey},
+		{name: "invalid token", err: Error{Code: 2001, Message: "Invalid token."}, expected: ErrUnauthorized},
+		{name: "already in account", err: Error{Code: 2003, Message: "L'utilisateur a déjà cette série dans son compte."}, expected: ErrAlreadyInAccount},
+		{name: "show not found", err: Error{Code: 4001, Message: "No series found."}, expected: ErrNotFound},
+		{name: "recommendation not found", err: Error{Code: 4005, Message: "La recommandation de série avec l'ID 106619 n'existe pas."}, expected: ErrNotFound},
+		{name: "not friends", err: Error{Code: 0, Message: "Les membres ne sont pas amis entre eux."}, expected: ErrNotFriends},
+		{name: "already recommended", err: Error{Code: 0, Message: "L'utilisateur a déjà recommandé cette série à ce membre."}, expected: ErrAlreadyRecommended},
+		{name: "missing parameter", err: Error{Code: 0, Message: "You must send an \"id\" or a \"thetvdb_id\" parameter to this API request."}, expected: ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Errors{tc.err}.Err()
+
+			assert.True(t, errors.Is(err, tc.expected))
+			assert.False(t, errors.Is(err, errors.New(tc.expected.Error())))
+			assert.True(t, errors.Is(err, &APIError{Code: tc.err.Code}))
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tc.err.Code, apiErr.Code)
+			assert.Equal(t, tc.err.Message, apiErr.Message)
+		})
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	err := Errors{
+		{Code: 2001, Message: "Invalid token."},
+		{Code: 4001, Message: "No series found."},
+	}.Err()
+
+	assert.Equal(t, "Code: 2001, Message: Invalid token.\nCode: 4001, Message: No series found.\n", err.Error())
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrAlreadyInAccount))
+
+	var apiErrs APIErrors
+	assert.True(t, errors.As(err, &apiErrs))
+	assert.Equal(t, 2, len(apiErrs))
+
+	assert.Nil(t, Errors{}.Err())
+}
+
+func TestAPIError_Response(t *testing.T) {
+	data, err := os.ReadFile("data/shows/no_series_found.json")
+	assert.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write(data)
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1)})
+	assert.Error(t, err)
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 4001, apiErr.Code)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "/shows/display", apiErr.Path)
+}
+
+func TestAPIError_Recommendation(t *testing.T) {
+	data, err := os.ReadFile("data/shows/recommendation_post_not_friends.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "shows/recommendation?id=1&to=2"), string(data))
+	defer ts.Close()
+
+	_, err = bc.Shows.CreateRecommendation(context.Background(), ShowsCreateRecommendationParams{
+		ID: Int(1),
+		To: 2,
+	})
+
+	assert.True(t, errors.Is(err, ErrNotFriends))
+}
@@ -0,0 +1,473 @@
+// Package filecache implements a gotaseries.Cache storing the responses on disk,
+// so that they are shared by short-lived processes like command line tools.
+//
+//	cache, err := filecache.New(filecache.DefaultDir(), 50<<20)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client, err := gotaseries.NewClient("YOUR_API_KEY", gotaseries.WithCache(cache, nil))
+//
+// Each entry is a JSON file named after the SHA-256 hash of its key, holding
+// the response body and its expiry metadata. An index file holds the expiry and
+// the tags of the entries, so that they are evicted and invalidated without
+// reading them. Files are written atomically, and the writes are serialized
+// between processes by an advisory lock on a lock file.
+package filecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/florentsorel/gotaseries"
+)
+
+const (
+	ext       = ".json"
+	lockName  = ".lock"
+	indexName = ".index"
+)
+
+// LockTimeout is the time waited for the lock of the cache directory by
+// Invalidate and Purge. Set does not wait for it.
+var LockTimeout = 5 * time.Second
+
+// ErrLocked is returned when the lock of the cache directory cannot be acquired.
+var ErrLocked = errors.New("filecache: cache directory is locked")
+
+// Cache is a gotaseries.Cache storing its entries as files in a directory.
+// It is safe for concurrent use by goroutines and processes.
+//
+// The methods of gotaseries.Cache ignore the file system errors, a failing
+// cache behaving like an empty one.
+type Cache struct {
+	dir      string
+	maxBytes int64
+}
+
+// Entry is an entry of the cache.
+type Entry struct {
+	Key     string          `json:"key"`
+	ETag    string          `json:"etag,omitempty"`
+	Expires time.Time       `json:"expires"`
+	Tags    []string        `json:"tags,omitempty"`
+	Body    json.RawMessage `json:"body"`
+
+	// Size is the size of the file and ModTime the time of its last access,
+	// used to evict the least recently used entries.
+	Size    int64     `json:"-"`
+	ModTime time.Time `json:"-"`
+	path    string
+}
+
+// Expired reports whether the entry is expired.
+func (e *Entry) Expired() bool {
+	return !time.Now().Before(e.Expires)
+}
+
+// index holds the expiry and the tags of the entries, by file name.
+type index map[string]indexEntry
+
+type indexEntry struct {
+	Expires time.Time `json:"expires"`
+	Tags    []string  `json:"tags,omitempty"`
+}
+
+// DefaultDir returns the gotaseries directory of the user cache directory.
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "gotaseries")
+}
+
+// New returns a cache storing its entries in dir, created if needed. The
+// expired entries are removed by each write, instead of being kept to be
+// revalidated with their ETag, and the least recently used entries are evicted
+// when the files exceed maxBytes, which is not limited when 0.
+func New(dir string, maxBytes int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("filecache: %w", err)
+	}
+
+	return &Cache{dir: dir, maxBytes: maxBytes}, nil
+}
+
+// Dir returns the directory of the cache.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, fileName(key))
+}
+
+func fileName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + ext
+}
+
+// Get returns the entry of the key and marks it as recently used.
+func (c *Cache) Get(key string) (*gotaseries.CacheEntry, bool) {
+	path := c.path(key)
+
+	e, err := readEntry(path)
+	if err != nil || e.Key != key {
+		return nil, false
+	}
+
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	return &gotaseries.CacheEntry{
+		Body:    e.Body,
+		ETag:    e.ETag,
+		Expires: e.Expires,
+		Tags:    e.Tags,
+	}, true
+}
+
+// Set stores the entry of the key, then evicts the expired entries and the
+// least recently used ones if the cache is full. Set does not wait for the lock
+// of the cache directory: the entry is not stored while another goroutine or
+// process holds it, like any other failure of the cache.
+func (c *Cache) Set(key string, entry *gotaseries.CacheEntry) {
+	data, err := json.Marshal(Entry{
+		Key:     key,
+		ETag:    entry.ETag,
+		Expires: entry.Expires,
+		Tags:    entry.Tags,
+		Body:    entry.Body,
+	})
+	if err != nil {
+		return
+	}
+
+	_ = c.withLock(0, func() error {
+		if err := writeFile(c.path(key), data); err != nil {
+			return err
+		}
+
+		idx, err := c.readIndex()
+		if err != nil {
+			return err
+		}
+		idx[fileName(key)] = indexEntry{Expires: entry.Expires, Tags: entry.Tags}
+
+		if err := c.evict(idx); err != nil {
+			return err
+		}
+
+		return c.writeIndex(idx)
+	})
+}
+
+// Invalidate removes the entries having the tag. When the lock of the cache
+// directory cannot be acquired, the files of the entries are removed anyway so
+// that they are not served, the index being cleaned up by the next write.
+func (c *Cache) Invalidate(tag string) {
+	err := c.withLock(LockTimeout, func() error {
+		idx, err := c.readIndex()
+		if err != nil {
+			return err
+		}
+
+		if err := c.removeTagged(idx, tag); err != nil {
+			return err
+		}
+
+		return c.writeIndex(idx)
+	})
+	if err == nil {
+		return
+	}
+
+	if idx, err := c.readIndex(); err == nil {
+		_ = c.removeTagged(idx, tag)
+	}
+}
+
+// removeTagged removes the files of the entries having the tag from the
+// directory and from idx.
+func (c *Cache) removeTagged(idx index, tag string) error {
+	for name, e := range idx {
+		if !hasTag(e.Tags, tag) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("filecache: %w", err)
+		}
+		delete(idx, name)
+	}
+
+	return nil
+}
+
+// Entries returns the entries of the cache, the least recently used first.
+func (c *Cache) Entries() ([]*Entry, error) {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, fmt.Errorf("filecache: %w", err)
+	}
+
+	entries := make([]*Entry, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ext) {
+			continue
+		}
+
+		e, err := readEntry(filepath.Join(c.dir, f.Name()))
+		if err != nil {
+			// Removed by another process or not an entry.
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModTime.Before(entries[j].ModTime)
+	})
+
+	return entries, nil
+}
+
+// Purge removes the entries for which match returns true, all of them when
+// match is nil, and returns the number of entries removed.
+func (c *Cache) Purge(match func(e *Entry) bool) (int, error) {
+	var n int
+	err := c.withLock(LockTimeout, func() error {
+		entries, err := c.Entries()
+		if err != nil {
+			return err
+		}
+
+		idx, err := c.readIndex()
+		if err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			if match != nil && !match(e) {
+				continue
+			}
+			if err := os.Remove(e.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("filecache: %w", err)
+			}
+			delete(idx, filepath.Base(e.path))
+			n++
+		}
+
+		return c.writeIndex(idx)
+	})
+
+	return n, err
+}
+
+// evict drops the entries removed from the directory from idx, removes the
+// expired entries, then the least recently used ones until the cache fits in
+// maxBytes. It only reads the metadata of the files and must be called with the
+// lock held.
+func (c *Cache) evict(idx index) error {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("filecache: %w", err)
+	}
+
+	type file struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+
+	now := time.Now()
+	files := make([]file, 0, len(dirEntries))
+	present := make(map[string]bool, len(dirEntries))
+
+	var size int64
+	for _, d := range dirEntries {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ext) {
+			continue
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			// Removed by another process.
+			continue
+		}
+
+		if e, ok := idx[d.Name()]; ok && !now.Before(e.Expires) {
+			if err := os.Remove(filepath.Join(c.dir, d.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("filecache: %w", err)
+			}
+			continue
+		}
+
+		files = append(files, file{
+			name:    d.Name(),
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		})
+		present[d.Name()] = true
+		size += info.Size()
+	}
+
+	for name := range idx {
+		if !present[name] {
+			delete(idx, name)
+		}
+	}
+
+	if c.maxBytes <= 0 || size <= c.maxBytes {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	for _, f := range files {
+		if size <= c.maxBytes {
+			break
+		}
+		if err := os.Remove(filepath.Join(c.dir, f.name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("filecache: %w", err)
+		}
+		delete(idx, f.name)
+		size -= f.size
+	}
+
+	return nil
+}
+
+// readIndex returns the index of the cache, rebuilt from the entries when it
+// is missing or corrupted.
+func (c *Cache) readIndex() (index, error) {
+	data, err := os.ReadFile(filepath.Join(c.dir, indexName))
+	if err == nil {
+		var idx index
+		if json.Unmarshal(data, &idx) == nil && idx != nil {
+			return idx, nil
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("filecache: %w", err)
+	}
+
+	entries, err := c.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(index, len(entries))
+	for _, e := range entries {
+		idx[filepath.Base(e.path)] = indexEntry{Expires: e.Expires, Tags: e.Tags}
+	}
+
+	return idx, nil
+}
+
+// writeIndex writes the index of the cache. It must be called with the lock held.
+func (c *Cache) writeIndex(idx index) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return fmt.Errorf("filecache: %w", err)
+	}
+
+	if err := writeFile(filepath.Join(c.dir, indexName), data); err != nil {
+		return fmt.Errorf("filecache: %w", err)
+	}
+
+	return nil
+}
+
+// withLock runs fn holding the lock of the cache directory, waiting for it up
+// to timeout. The lock is an advisory lock released by the operating system if
+// the process crashes.
+func (c *Cache) withLock(timeout time.Duration, fn func() error) error {
+	lock := filepath.Join(c.dir, lockName)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		unlock, ok, err := tryLock(lock)
+		if err != nil {
+			return fmt.Errorf("filecache: %w", err)
+		}
+
+		if ok {
+			defer unlock()
+			return fn()
+		}
+
+		if !time.Now().Before(deadline) {
+			return ErrLocked
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func readEntry(path string) (*Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+
+	e.Size = info.Size()
+	e.ModTime = info.ModTime()
+	e.path = path
+
+	return &e, nil
+}
+
+// writeFile writes the file atomically, so that concurrent readers never see
+// a partial entry.
+func writeFile(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+
+	return nil
+}
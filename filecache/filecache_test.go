@@ -0,0 +1,203 @@
+package filecache_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/florentsorel/gotaseries"
+	"github.com/florentsorel/gotaseries/filecache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_SetGet(t *testing.T) {
+	cache, err := filecache.New(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	expires := time.Now().Add(time.Hour).Round(time.Second)
+	cache.Set("GET /shows/display?id=1161", &gotaseries.CacheEntry{
+		Body:    []byte(`{"show": {"id": 1161}}`),
+		ETag:    `"v1"`,
+		Expires: expires,
+		Tags:    []string{"show:1161"},
+	})
+
+	entry, ok := cache.Get("GET /shows/display?id=1161")
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"show": {"id": 1161}}`, string(entry.Body))
+	assert.Equal(t, `"v1"`, entry.ETag)
+	assert.True(t, expires.Equal(entry.Expires))
+	assert.Equal(t, []string{"show:1161"}, entry.Tags)
+
+	_, ok = cache.Get("GET /shows/display?id=1")
+	assert.False(t, ok)
+
+	// A second cache on the same directory, like another process, shares the entries.
+	other, err := filecache.New(cache.Dir(), 0)
+	assert.NoError(t, err)
+
+	_, ok = other.Get("GET /shows/display?id=1161")
+	assert.True(t, ok)
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	cache, err := filecache.New(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	for _, id := range []int{1, 2} {
+		for _, locale := range []string{"fr", "en"} {
+			cache.Set(fmt.Sprintf("GET /shows/display?id=%d&locale=%s", id, locale), &gotaseries.CacheEntry{
+				Body:    []byte(`{}`),
+				Expires: time.Now().Add(time.Hour),
+				Tags:    []string{fmt.Sprintf("show:%d", id)},
+			})
+		}
+	}
+
+	cache.Invalidate("show:1")
+
+	entries, err := cache.Entries()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.Equal(t, []string{"show:2"}, e.Tags)
+	}
+
+	// The index is rebuilt from the entries when missing.
+	assert.NoError(t, os.Remove(filepath.Join(cache.Dir(), ".index")))
+	cache.Invalidate("show:2")
+
+	entries, err = cache.Entries()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestCache_Evict(t *testing.T) {
+	dir := t.TempDir()
+
+	cache, err := filecache.New(dir, 0)
+	assert.NoError(t, err)
+
+	body := []byte(fmt.Sprintf(`{"summary": %q}`, strings.Repeat("a", 100)))
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		cache.Set(fmt.Sprintf("key%d", i), &gotaseries.CacheEntry{Body: body, Expires: now.Add(time.Hour)})
+	}
+
+	// Distinct access times, key0 being the least recently used.
+	entries, err := cache.Entries()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	for _, e := range entries {
+		i, _ := strconv.Atoi(strings.TrimPrefix(e.Key, "key"))
+		mtime := now.Add(time.Duration(i-10) * time.Second)
+		assert.NoError(t, os.Chtimes(filepath.Join(dir, entryFile(e.Key)), mtime, mtime))
+	}
+
+	// Room for 2 entries: the least recently used are evicted.
+	limited, err := filecache.New(dir, 2*entries[0].Size+entries[0].Size/2)
+	assert.NoError(t, err)
+
+	limited.Set("key4", &gotaseries.CacheEntry{Body: body, Expires: now.Add(time.Hour)})
+
+	assert.Equal(t, []string{"key3", "key4"}, keys(t, limited))
+}
+
+func TestCache_EvictExpired(t *testing.T) {
+	// The expired entries are removed even though the cache is not limited.
+	cache, err := filecache.New(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	cache.Set("key0", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: now.Add(time.Hour)})
+	cache.Set("key1", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: now.Add(-time.Hour)})
+	cache.Set("key2", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: now.Add(time.Hour)})
+
+	assert.ElementsMatch(t, []string{"key0", "key2"}, keys(t, cache))
+}
+
+// keys returns the keys of the entries of the cache, the least recently used first.
+func keys(t *testing.T, cache *filecache.Cache) []string {
+	t.Helper()
+
+	entries, err := cache.Entries()
+	assert.NoError(t, err)
+
+	var keys []string
+	for _, e := range entries {
+		keys = append(keys, e.Key)
+	}
+	return keys
+}
+
+// entryFile returns the name of the file of the entry of the key.
+func entryFile(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + ".json"
+}
+
+func TestCache_Concurrent(t *testing.T) {
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		cache, err := filecache.New(dir, 4096)
+		assert.NoError(t, err)
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				key := fmt.Sprintf("key%d", j)
+				cache.Set(key, &gotaseries.CacheEntry{Body: []byte(fmt.Sprintf(`{"writer": %d}`, i)), Expires: time.Now().Add(time.Hour), Tags: []string{"t"}})
+				if entry, ok := cache.Get(key); ok {
+					assert.Contains(t, string(entry.Body), `"writer"`)
+				}
+				if j%5 == 0 {
+					cache.Invalidate("t")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	matches, _ := filepath.Glob(filepath.Join(dir, ".tmp-*"))
+	assert.Empty(t, matches)
+}
+
+func TestCache_Client(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write([]byte(`{"show": {"id": 1161, "title": "Game of Thrones"}}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+
+	// Each client stands for a short-lived process.
+	for i := 0; i < 3; i++ {
+		cache, err := filecache.New(dir, 0)
+		assert.NoError(t, err)
+
+		bc, err := gotaseries.NewClient("api_key", gotaseries.WithBaseURL(ts.URL), gotaseries.WithCache(cache, nil))
+		assert.NoError(t, err)
+
+		show, err := bc.Shows.Display(context.Background(), gotaseries.ShowsDisplayParams{ID: gotaseries.Int(1161)})
+		assert.NoError(t, err)
+		assert.Equal(t, "Game of Thrones", show.Title)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
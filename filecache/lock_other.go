@@ -0,0 +1,46 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+
+package filecache
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+)
+
+// tryLock acquires the lock by creating the file without waiting. ok is false
+// when the file exists. The file holds a random token, so that the lock is only
+// removed by its holder. A lock file left by a crashed process must be removed
+// by hand.
+func tryLock(path string) (unlock func(), ok bool, err error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, err
+	}
+	token := []byte(hex.EncodeToString(b))
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	_, err = f.Write(token)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, false, err
+	}
+
+	return func() {
+		if data, err := os.ReadFile(path); err == nil && bytes.Equal(data, token) {
+			_ = os.Remove(path)
+		}
+	}, true, nil
+}
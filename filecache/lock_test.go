@@ -0,0 +1,92 @@
+package filecache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/florentsorel/gotaseries"
+	"github.com/stretchr/testify/assert"
+)
+
+// lock holds the lock of the cache directory like another process would.
+func lock(t *testing.T, c *Cache) func() {
+	t.Helper()
+
+	unlock, ok, err := tryLock(filepath.Join(c.dir, lockName))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	return unlock
+}
+
+func TestCache_Locked(t *testing.T) {
+	c, err := New(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	defer func(timeout time.Duration) { LockTimeout = timeout }(LockTimeout)
+	LockTimeout = 50 * time.Millisecond
+
+	unlock := lock(t, c)
+
+	_, err = c.Purge(nil)
+	assert.ErrorIs(t, err, ErrLocked)
+
+	unlock()
+
+	_, err = c.Purge(nil)
+	assert.NoError(t, err)
+}
+
+func TestCache_InvalidateLocked(t *testing.T) {
+	c, err := New(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	expires := time.Now().Add(time.Hour)
+	c.Set("a", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: expires, Tags: []string{"show:1"}})
+	c.Set("b", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: expires, Tags: []string{"show:2"}})
+
+	defer func(timeout time.Duration) { LockTimeout = timeout }(LockTimeout)
+	LockTimeout = 50 * time.Millisecond
+
+	unlock := lock(t, c)
+
+	// The invalidated entry is not served even though the lock is held.
+	c.Invalidate("show:1")
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+
+	unlock()
+
+	// The next write cleans the index up.
+	c.Set("c", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: expires})
+
+	idx, err := c.readIndex()
+	assert.NoError(t, err)
+	assert.Len(t, idx, 2)
+	assert.NotContains(t, idx, fileName("a"))
+}
+
+func TestCache_SetLocked(t *testing.T) {
+	c, err := New(t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	unlock := lock(t, c)
+
+	// Set does not wait for the lock, the entry is not stored.
+	start := time.Now()
+	c.Set("a", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: time.Now().Add(time.Hour)})
+	assert.Less(t, time.Since(start), LockTimeout)
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+
+	unlock()
+
+	c.Set("a", &gotaseries.CacheEntry{Body: []byte(`{}`), Expires: time.Now().Add(time.Hour)})
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+}
@@ -0,0 +1,166 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	FindFriendsEmails   FindFriendsType = "emails"
+	FindFriendsContacts FindFriendsType = "contacts"
+
+	FriendshipOpen       FriendshipType = "open"
+	FriendshipValidation FriendshipType = "validation"
+	FriendshipClosed     FriendshipType = "closed"
+)
+
+type FriendService Service
+
+type FindFriendsType string
+
+type FriendshipType string
+
+type usersResponse struct {
+	Users  []MemberSummary `json:"users"`
+	Errors Errors          `json:"errors"`
+}
+
+type friendResponse struct {
+	Member MemberSummary `json:"member"`
+	Errors Errors        `json:"errors"`
+}
+
+// MemberSummary is the short representation of a member returned by the friends
+// endpoints. Its ID is the one expected by ShowService.CreateRecommendation.
+type MemberSummary struct {
+	ID     int    `json:"id"`
+	Login  string `json:"login"`
+	XP     int    `json:"xp"`
+	Avatar string `json:"avatar"`
+}
+
+type FriendsListParams struct {
+	ID      *int        `url:"id"`
+	Blocked *bool       `url:"blocked"`
+	Order   *OrderType  `url:"order"`
+	Locale  *LocaleType `url:"locale"`
+}
+
+type FriendsRequestsParams struct {
+	Received *bool       `url:"received"`
+	Locale   *LocaleType `url:"locale"`
+}
+
+type FriendsFriendParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type FriendsBlockParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type FriendsFindParams struct {
+	Type   FindFriendsType `url:"type"`
+	Emails []string        `url:"emails"`
+	Locale *LocaleType     `url:"locale"`
+}
+
+type FriendsOptionsParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type FriendsUpdateOptionsParams struct {
+	Friendship FriendshipType `url:"friendship"`
+	Locale     *LocaleType    `url:"locale"`
+}
+
+// List returns the friends of the authenticated member or ID member. (ID member has priority over token)
+func (f *FriendService) List(ctx context.Context, params FriendsListParams) ([]MemberSummary, error) {
+	var res usersResponse
+	if err := f.client.doRequest(ctx, http.MethodGet, "/friends/list", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+// Requests returns the friend requests sent by the authenticated member, or received when Received is true.
+// Require a valid token.
+func (f *FriendService) Requests(ctx context.Context, params FriendsRequestsParams) ([]MemberSummary, error) {
+	var res usersResponse
+	if err := f.client.doRequest(ctx, http.MethodGet, "/friends/requests", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+// Add add a member to the friends of the authenticated member.
+// Require a valid token.
+func (f *FriendService) Add(ctx context.Context, params FriendsFriendParams) (*MemberSummary, error) {
+	var res friendResponse
+	if err := f.client.doRequest(ctx, http.MethodPost, "/friends/friend", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Member, nil
+}
+
+// Delete remove a member from the friends of the authenticated member.
+// Require a valid token.
+func (f *FriendService) Delete(ctx context.Context, params FriendsFriendParams) (*MemberSummary, error) {
+	var res friendResponse
+	if err := f.client.doRequest(ctx, http.MethodDelete, "/friends/friend", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Member, nil
+}
+
+// Block block a member.
+// Require a valid token.
+func (f *FriendService) Block(ctx context.Context, params FriendsBlockParams) (*MemberSummary, error) {
+	var res friendResponse
+	if err := f.client.doRequest(ctx, http.MethodPost, "/friends/block", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Member, nil
+}
+
+// Unblock unblock a member.
+// Require a valid token.
+func (f *FriendService) Unblock(ctx context.Context, params FriendsBlockParams) (*MemberSummary, error) {
+	var res friendResponse
+	if err := f.client.doRequest(ctx, http.MethodDelete, "/friends/block", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Member, nil
+}
+
+// Find returns the members matching a list of email addresses or the contacts of the authenticated member.
+// Require a valid token.
+func (f *FriendService) Find(ctx context.Context, params FriendsFindParams) ([]MemberSummary, error) {
+	var res usersResponse
+	if err := f.client.doRequest(ctx, http.MethodGet, "/friends/find", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+// Options returns the friendship options of the authenticated member.
+// Require a valid token.
+func (f *FriendService) Options(ctx context.Context, params FriendsOptionsParams) (*MemberOptions, error) {
+	var res optionsResponse
+	if err := f.client.doRequest(ctx, http.MethodGet, "/friends/options", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Options, nil
+}
+
+// UpdateOptions update who can add the authenticated member as a friend.
+// Require a valid token.
+func (f *FriendService) UpdateOptions(ctx context.Context, params FriendsUpdateOptionsParams) (*MemberOptions, error) {
+	var res optionsResponse
+	if err := f.client.doRequest(ctx, http.MethodPost, "/friends/options", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Options, nil
+}
@@ -0,0 +1,112 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFriendService_List(t *testing.T) {
+	data, err := os.ReadFile("data/friends/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "friends/list?id=1&order=alphabetical"), string(data))
+	defer ts.Close()
+
+	friends, err := bc.Friends.List(context.Background(), FriendsListParams{
+		ID:    Int(1),
+		Order: Order(OrderAlphabetical),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(friends))
+	assert.Equal(t, MemberSummary{ID: 9876, Login: "test user", XP: 5120, Avatar: "/images/site/avatar-default.png"}, friends[0])
+	assert.Equal(t, "", friends[1].Avatar)
+}
+
+func TestFriendService_Requests(t *testing.T) {
+	data, err := os.ReadFile("data/friends/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "friends/requests?received=true"), string(data))
+	defer ts.Close()
+
+	friends, err := bc.Friends.Requests(context.Background(), FriendsRequestsParams{
+		Received: Bool(true),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(friends))
+}
+
+func TestFriendService_Friend(t *testing.T) {
+	testCases := []struct {
+		method string
+	}{
+		{method: "POST"},
+		{method: "DELETE"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			ts, bc := setup(t, tc.method, fmt.Sprintf("/%s", "friends/friend?id=9876"), `{"member": {"id": 9876, "login": "test user"}}`)
+			defer ts.Close()
+
+			params := FriendsFriendParams{ID: 9876}
+
+			var friend *MemberSummary
+			var err error
+			if tc.method == "POST" {
+				friend, err = bc.Friends.Add(context.Background(), params)
+			} else {
+				friend, err = bc.Friends.Delete(context.Background(), params)
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, "test user", friend.Login)
+		})
+	}
+}
+
+func TestFriendService_Block(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "friends/block?id=34"), `{"member": {"id": 34, "login": "another user"}}`)
+	defer ts.Close()
+
+	member, err := bc.Friends.Block(context.Background(), FriendsBlockParams{
+		ID: 34,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 34, member.ID)
+}
+
+func TestFriendService_Find(t *testing.T) {
+	data, err := os.ReadFile("data/friends/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "friends/find?emails=a%40example.com%2Cb%40example.com&type=emails"), string(data))
+	defer ts.Close()
+
+	friends, err := bc.Friends.Find(context.Background(), FriendsFindParams{
+		Type:   FindFriendsEmails,
+		Emails: []string{"a@example.com", "b@example.com"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(friends))
+}
+
+func TestFriendService_UpdateOptions(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "friends/options?friendship=validation"), `{"options": {"friendship": "validation"}}`)
+	defer ts.Close()
+
+	options, err := bc.Friends.UpdateOptions(context.Background(), FriendsUpdateOptionsParams{
+		Friendship: FriendshipValidation,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "validation", options.Friendship)
+}
@@ -1,13 +1,16 @@
 package gotaseries
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
-	"strconv"
+	"sort"
 	"strings"
 	"time"
 )
@@ -15,6 +18,13 @@ import (
 const (
 	baseURL = "https://api.betaseries.com/api"
 	version = "3.0"
+
+	formContentType = "application/x-www-form-urlencoded"
+
+	// MaxResponseSize is the maximum size in bytes of a response body read by
+	// the client. A larger body fails with an *HTTPError wrapping
+	// ErrResponseTooLarge.
+	MaxResponseSize = 10 << 20
 )
 
 type Service struct {
@@ -23,23 +33,56 @@ type Service struct {
 
 // Client represents a Betaseries client.
 type Client struct {
-	baseURL    url.URL
-	userAgent  string
-	apiKey     string
-	Token      string
-	Locale     LocaleType
-	httpClient *http.Client
+	baseURL   url.URL
+	userAgent string
+	apiKey    string
+
+	// Token authenticates the requests as a member. Token and Locale must not be
+	// modified while requests are in flight, use WithToken and WithLocale to get
+	// a client for another member or locale instead.
+	Token  string
+	Locale LocaleType
+
+	httpClient  *http.Client
+	logger      Logger
+	middlewares []Middleware
+	tracer      Tracer
+	meter       Meter
+	cache       Cache
+	cacheTTLs   CacheTTLs
+	flights     *flightGroup
 
-	common Service
-	Shows  *ShowService
-	Badges *BadgeService
+	// Retry is the policy used to retry requests failing with a transient error. Requests are not retried when nil.
+	Retry *RetryPolicy
+	// Limiter limits the rate of the requests sent by the client. Requests are not limited when nil.
+	Limiter *RateLimiter
+
+	common   Service
+	Shows    *ShowService
+	Episodes *EpisodeService
+	Movies   *MovieService
+	Members  *MemberService
+	Planning *PlanningService
+	Comments *CommentService
+	Friends  *FriendService
+	Messages *MessageService
+	Badges   *BadgeService
 }
 
 // NewClient returns a new Betaseries client. You need to provide an API key.
-func NewClient(apiKey string) *Client {
+// Options are applied in order and NewClient returns the first error they
+// report, for instance an invalid base URL.
+//
+// Example:
+//
+//	client, err := gotaseries.NewClient("YOUR_API_KEY",
+//		gotaseries.WithLocale(gotaseries.LocaleEN),
+//		gotaseries.WithRetry(gotaseries.DefaultRetryPolicy()),
+//	)
+func NewClient(apiKey string, opts ...Option) (*Client, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	httpClient := &http.Client{
@@ -53,13 +96,61 @@ func NewClient(apiKey string) *Client {
 		Token:      "",
 		Locale:     "",
 		httpClient: httpClient,
+		flights:    newFlightGroup(),
 	}
 
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	c.initServices()
+
+	return c, nil
+}
+
+func (c *Client) initServices() {
 	c.common.client = c
 	c.Shows = (*ShowService)(&c.common)
+	c.Episodes = (*EpisodeService)(&c.common)
+	c.Movies = (*MovieService)(&c.common)
+	c.Members = (*MemberService)(&c.common)
+	c.Planning = (*PlanningService)(&c.common)
+	c.Comments = (*CommentService)(&c.common)
+	c.Friends = (*FriendService)(&c.common)
+	c.Messages = (*MessageService)(&c.common)
 	c.Badges = (*BadgeService)(&c.common)
+}
+
+// WithToken returns a copy of the client authenticating its requests with the
+// token of another member. The copy shares the HTTP client, the retry policy
+// and the rate limiter of the client, so a single client can serve many
+// members concurrently.
+//
+// Example:
+//
+//	member := client.WithToken("MEMBER_TOKEN")
+//	shows, err := member.Shows.Member(ctx, gotaseries.ShowsMemberParams{})
+func (c *Client) WithToken(token string) *Client {
+	clone := c.clone()
+	clone.Token = token
+	return clone
+}
 
-	return c
+// WithLocale returns a copy of the client using another default locale. Like
+// WithToken, the copy shares the HTTP client, the retry policy and the rate
+// limiter of the client.
+func (c *Client) WithLocale(locale LocaleType) *Client {
+	clone := c.clone()
+	clone.Locale = locale
+	return clone
+}
+
+func (c *Client) clone() *Client {
+	clone := *c
+	clone.initServices()
+	return &clone
 }
 
 func (c *Client) doRequest(ctx context.Context, method, urlStr string, params any, response errorableResponse) error {
@@ -68,29 +159,217 @@ func (c *Client) doRequest(ctx context.Context, method, urlStr string, params an
 		return err
 	}
 
-	err = c.do(req, response)
+	return c.send(req, urlStr, response)
+}
+
+// send executes the request, retrying it according to the retry policy, and
+// returns the Betaseries errors of the response as APIErrors.
+//
+// The responses of GET requests are served from the cache when fresh, and the
+// cached entries of a show are invalidated by the other requests on it. The
+// concurrent identical GET requests share a single HTTP call.
+func (c *Client) send(req *http.Request, urlStr string, response errorableResponse) error {
+	cr := c.cacheRequest(req, urlStr)
+	if cr.fresh() {
+		responseFromContext(req.Context()).cached()
+		return json.Unmarshal(cr.entry.Body, response)
+	}
+
+	if req.Method != http.MethodGet || c.flights == nil {
+		_, err := c.exchange(req, urlStr, cr, response)
+		return err
+	}
+
+	return c.flights.do(req, cacheKey(req), response, func(req *http.Request, response errorableResponse) ([]byte, error) {
+		return c.exchange(req, urlStr, cr, response)
+	})
+}
+
+// exchange sends the request and decodes its response into response. It
+// returns the body decoded, which is the one of the cached entry when it was
+// revalidated.
+func (c *Client) exchange(req *http.Request, urlStr string, cr *cacheRequest, response errorableResponse) ([]byte, error) {
+	cr.revalidate(req)
+
+	req, end := c.instrument(req, urlStr)
+	res, body, err := c.attempt(req, urlStr, response)
+	end(res, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cr != nil {
+		return c.store(cr, res, body, response)
+	}
+
+	c.invalidate(req, urlStr, body)
+
+	return body, nil
+}
+
+// attempt sends the request until it succeeds or cannot be retried, and
+// returns the last response received with its body.
+func (c *Client) attempt(req *http.Request, urlStr string, response errorableResponse) (*http.Response, []byte, error) {
+	meta := responseFromContext(req.Context())
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		if err := c.Limiter.Wait(req.Context()); err != nil {
+			return nil, nil, err
+		}
+
+		var err error
+		if req, err = c.beforeSend(req); err != nil {
+			return nil, nil, err
+		}
+
+		res, body, err := c.do(req, response)
+		if err == nil {
+			err = response.GetErrors().err(res, urlStr)
+		}
+
+		c.Limiter.observe(err)
+		meta.record(res, attempt, start)
+		c.afterReceive(req, res, err)
+
+		if err == nil || !c.Retry.retryable(req, attempt, err) {
+			return res, body, err
+		}
+
+		backoff := c.Retry.backoff(attempt, err)
+		if c.logger != nil {
+			c.logger.Printf("gotaseries: %s %s failed (attempt %d): %v, retrying in %s", req.Method, urlStr, attempt, err, backoff)
+		}
+
+		if serr := sleep(req.Context(), backoff); serr != nil {
+			return res, body, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, nil, err
+		}
+
+		reset(response)
+	}
+}
+
+// rewind returns a copy of the request ready to be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+// newResponse returns a new response of the type of response.
+func newResponse(response errorableResponse) errorableResponse {
+	return reflect.New(reflect.TypeOf(response).Elem()).Interface().(errorableResponse)
+}
+
+// reset clears a response partially decoded by a failed attempt.
+func reset(response errorableResponse) {
+	v := reflect.ValueOf(response)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
+}
+
+func (c *Client) doUpload(ctx context.Context, urlStr, field, filename string, file io.Reader, response errorableResponse) error {
+	// The parameters are sent as fields of the multipart body, which replaces
+	// the form body of the request.
+	values, err := c.encodeParams(nil)
 	if err != nil {
 		return err
 	}
 
-	if err = response.GetErrors().Err(); err != nil {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range values[k] {
+			if err = w.WriteField(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	part, err := w.CreateFormFile(field, filename)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	if _, err = io.Copy(part, file); err != nil {
+		return err
+	}
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, urlStr, struct{}{})
+	if err != nil {
+		return err
+	}
+
+	data := body.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return c.send(req, urlStr, response)
 }
 
-func (c *Client) newRequest(ctx context.Context, method, url string, params any) (*http.Request, error) {
-	u, err := c.buildURL(url, params)
+// newRequest returns a request for the given path. The parameters are sent as a
+// form-encoded body for POST, PUT and PATCH requests, long texts like comments
+// or messages would overflow the URL length limits, and in the query string
+// otherwise: servers and proxies often ignore the body of DELETE requests.
+// JSON bodies are never sent, the Betaseries API only documents form parameters.
+func (c *Client) newRequest(ctx context.Context, method, urlStr string, params any) (*http.Request, error) {
+	u, err := c.baseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := c.encodeParams(params)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(method, u.String(), nil)
+	var body io.Reader
+	if hasBody(method) {
+		body = strings.NewReader(values.Encode())
+	} else {
+		q := u.Query()
+		for k, v := range values {
+			q[k] = v
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	if body != nil {
+		req.Header.Set("Content-Type", formContentType)
+	}
+
 	if ctx == nil {
 		return nil, fmt.Errorf("context cannot be nil")
 	}
@@ -107,99 +386,68 @@ func (c *Client) newRequest(ctx context.Context, method, url string, params any)
 	return req, nil
 }
 
-func (c *Client) do(req *http.Request, v any) error {
+// do sends the request, decodes the body into v and returns it. The body of the
+// response is replaced by a copy, which the middlewares can read. It returns an
+// *HTTPError when the response is not a Betaseries response: an error status
+// without API errors, an empty body where one is expected, a body which is not
+// JSON or larger than MaxResponseSize.
+func (c *Client) do(req *http.Request, v errorableResponse) (*http.Response, []byte, error) {
 	res, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
-	err = json.NewDecoder(res.Body).Decode(v)
-
-	return err
-}
-
-func (c *Client) buildURL(urlStr string, params any) (*url.URL, error) {
-	u, err := c.baseURL.Parse(urlStr)
+	body, err := io.ReadAll(io.LimitReader(res.Body, MaxResponseSize+1))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	v := reflect.ValueOf(params)
-	paramMap := make(map[string]any, v.NumField())
-	for i := 0; i < v.NumField(); i++ {
-		if v.Field(i).Kind() != reflect.Ptr {
-			tag := v.Type().Field(i).Tag.Get("url")
-			if tag != "" {
-				paramMap[tag] = v.Field(i).Interface()
-			}
-			continue
-		}
+	if len(body) > MaxResponseSize {
+		body = body[:MaxResponseSize]
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		return res, body, newHTTPError(req, res, body, ErrResponseTooLarge)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
 
-		if v.Field(i).IsNil() {
-			continue
+	if len(bytes.TrimSpace(body)) == 0 {
+		if res.StatusCode >= http.StatusBadRequest {
+			return res, body, newHTTPError(req, res, body, nil)
 		}
+		return res, body, nil
+	}
 
-		tag := v.Type().Field(i).Tag.Get("url")
-		if tag != "" {
-			paramMap[tag] = v.Field(i).Elem().Interface()
-		}
+	if err = json.Unmarshal(body, v); err != nil {
+		return res, body, newHTTPError(req, res, body, err)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest && len(v.GetErrors()) == 0 {
+		return res, body, newHTTPError(req, res, body, nil)
+	}
+
+	return res, body, nil
+}
+
+// hasBody reports whether the parameters of a request are sent in its body.
+func hasBody(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
 	}
+	return false
+}
 
-	q := u.Query()
+// encodeParams returns the parameters of a request: the locale of the client
+// and the fields of params, see encodeQuery.
+func (c *Client) encodeParams(params any) (url.Values, error) {
+	q := url.Values{}
 	if c.Locale != "" {
-		q.Add("locale", c.Locale.String())
-	}
-
-	if len(paramMap) > 0 {
-		for k, value := range paramMap {
-			switch val := value.(type) {
-			case bool:
-				q.Set(k, strconv.FormatBool(val))
-			case int:
-				q.Set(k, strconv.Itoa(val))
-			case []int:
-				if len(val) == 0 {
-					continue
-				}
-				var strInts []string
-				for _, num := range val {
-					strInts = append(strInts, strconv.Itoa(num))
-				}
-				q.Set(k, strings.Join(strInts, ","))
-			case []string:
-				if len(val) == 0 {
-					continue
-				}
-				q.Set(k, strings.Join(val, ","))
-			case string:
-				q.Set(k, val)
-			case time.Time:
-				timestamp := strconv.Itoa(int(val.Unix()))
-				q.Set(k, timestamp)
-			case LocaleType:
-				q.Set(k, val.String())
-			case OrderType:
-				q.Set(k, string(val))
-			case OrderFavoriteType:
-				q.Set(k, string(val))
-			case OrderShowMemberType:
-				q.Set(k, string(val))
-			case OrderDateType:
-				q.Set(k, string(val))
-			case FormatType:
-				q.Set(k, string(val))
-			case RecommendationStatus:
-				q.Set(k, string(val))
-			case StatusFavoriteType:
-				q.Set(k, string(val))
-			case StatusShowMemberType:
-				q.Set(k, string(val))
-			}
-		}
+		q.Set("locale", c.Locale.String())
 	}
 
-	u.RawQuery = q.Encode()
+	if err := encodeQuery(params, q); err != nil {
+		return nil, err
+	}
 
-	return u, nil
+	return q, nil
 }
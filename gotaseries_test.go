@@ -1,10 +1,17 @@
 package gotaseries
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,50 +20,110 @@ import (
 
 func setup(t *testing.T, expectedMethod, expectedURL string, expectedJSON string) (*httptest.Server, *Client) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, expectedURL, r.URL.String())
+		assert.Equal(t, expectedURL, requestURL(t, r))
 		assert.Equal(t, expectedMethod, r.Method)
 		_, _ = w.Write([]byte(expectedJSON))
 	}))
 
-	mockURL, err := url.Parse(ts.URL)
+	c, err := NewClient("api_key",
+		WithBaseURL(ts.URL),
+		WithUserAgent("test"),
+	)
 	assert.NoError(t, err)
 
-	c := &Client{
-		baseURL:    *mockURL,
-		userAgent:  "test",
-		apiKey:     "api_key",
-		httpClient: &http.Client{Timeout: time.Second * 30},
+	return ts, c
+}
+
+// requestURL returns the URL of the request with the parameters of a
+// form-encoded body moved to its query string.
+func requestURL(t *testing.T, r *http.Request) string {
+	if r.Header.Get("Content-Type") != formContentType {
+		return r.URL.String()
 	}
 
-	c.common.client = c
-	c.Shows = (*ShowService)(&c.common)
-	c.Badges = (*BadgeService)(&c.common)
+	assert.Empty(t, r.URL.RawQuery)
 
-	return ts, c
-}
+	body, err := io.ReadAll(r.Body)
+	assert.NoError(t, err)
 
-func TestNewClient(t *testing.T) {
-	client := NewClient("api_key")
+	if len(body) == 0 {
+		return r.URL.Path
+	}
 
-	expectedURL, err := url.Parse("http://api.test.com")
-	assert.Nil(t, err)
+	return r.URL.Path + "?" + string(body)
+}
 
-	client.baseURL = *expectedURL
+func TestNewClient(t *testing.T) {
+	client, err := NewClient("api_key")
+	assert.NoError(t, err)
 
 	assert.NotNil(t, client)
 	assert.Equal(t, "api_key", client.apiKey)
+	assert.Equal(t, baseURL, client.baseURL.String())
+	assert.Equal(t, "gotaseries/"+version, client.userAgent)
+	assert.Equal(t, 30*time.Second, client.httpClient.Timeout)
+	assert.Equal(t, client, client.Shows.client)
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	transport := &http.Transport{}
+	policy := DefaultRetryPolicy()
+	limiter := NewRateLimiter(5, 10)
+	logger := log.New(io.Discard, "", 0)
+
+	client, err := NewClient("api_key",
+		WithHTTPClient(httpClient),
+		WithTransport(transport),
+		WithBaseURL("http://api.test.com"),
+		WithUserAgent("gotaseries-user-agent"),
+		WithToken("token"),
+		WithLocale(LocaleEN),
+		WithRetry(policy),
+		WithRateLimiter(limiter),
+		WithLogger(logger),
+	)
+	assert.NoError(t, err)
+
 	assert.Equal(t, "http://api.test.com", client.baseURL.String())
+	assert.Equal(t, "gotaseries-user-agent", client.userAgent)
+	assert.Equal(t, "token", client.Token)
+	assert.Equal(t, LocaleEN, client.Locale)
+	assert.Equal(t, time.Second, client.httpClient.Timeout)
+	assert.Equal(t, transport, client.httpClient.Transport)
+	assert.Nil(t, httpClient.Transport)
+	assert.Equal(t, policy, client.Retry)
+	assert.Equal(t, limiter, client.Limiter)
+	assert.Equal(t, logger, client.logger)
+}
+
+func TestNewClientWithInvalidOptions(t *testing.T) {
+	testCases := []struct {
+		name string
+		opt  Option
+	}{
+		{name: "invalid url", opt: WithBaseURL("http://api test.com")},
+		{name: "relative url", opt: WithBaseURL("/api")},
+		{name: "nil http client", opt: WithHTTPClient(nil)},
+		{name: "nil transport", opt: WithTransport(nil)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := NewClient("api_key", tc.opt)
+			assert.Error(t, err)
+			assert.Nil(t, client)
+		})
+	}
 }
 
 func TestNewRequest(t *testing.T) {
-	client := NewClient("api_key")
-	apiURL, err := url.Parse("http://api.test.com")
+	client, err := NewClient("api_key",
+		WithBaseURL("http://api.test.com"),
+		WithUserAgent("gotaseries-user-agent"),
+	)
 	assert.NoError(t, err)
 
-	client.baseURL = *apiURL
-	client.userAgent = "gotaseries-user-agent"
-	client.apiKey = "api_key"
-
 	req, err := client.newRequest(context.Background(), "GET", "/test", ShowsListParams{})
 	assert.NoError(t, err)
 
@@ -67,15 +134,13 @@ func TestNewRequest(t *testing.T) {
 }
 
 func TestNewRequestWithToken(t *testing.T) {
-	client := NewClient("api_key")
-	client.Token = "token"
-	apiURL, err := url.Parse("http://api.test.com")
+	client, err := NewClient("api_key",
+		WithBaseURL("http://api.test.com"),
+		WithUserAgent("gotaseries-user-agent"),
+		WithToken("token"),
+	)
 	assert.NoError(t, err)
 
-	client.baseURL = *apiURL
-	client.userAgent = "gotaseries-user-agent"
-	client.apiKey = "api_key"
-
 	req, err := client.newRequest(context.Background(), "GET", "/test", ShowsListParams{})
 	assert.NoError(t, err)
 
@@ -87,15 +152,13 @@ func TestNewRequestWithToken(t *testing.T) {
 }
 
 func TestNewRequestWithInvalidToken(t *testing.T) {
-	client := NewClient("api_key")
-	client.Token = "invalid_token"
-	apiURL, err := url.Parse("http://api.test.com")
+	client, err := NewClient("api_key",
+		WithBaseURL("http://api.test.com"),
+		WithUserAgent("gotaseries-user-agent"),
+		WithToken("invalid_token"),
+	)
 	assert.NoError(t, err)
 
-	client.baseURL = *apiURL
-	client.userAgent = "gotaseries-user-agent"
-	client.apiKey = "api_key"
-
 	req, err := client.newRequest(context.Background(), "GET", "/test", ShowsListParams{})
 	assert.NoError(t, err)
 
@@ -106,14 +169,61 @@ func TestNewRequestWithInvalidToken(t *testing.T) {
 	assert.Equal(t, "3.0", req.Header.Get("X-BetaSeries-Version"))
 }
 
-func TestNewRequestWithParams(t *testing.T) {
-	client := NewClient("api_key")
-	apiURL, err := url.Parse("http://api.test.com")
+func TestClient_WithToken(t *testing.T) {
+	client, err := NewClient("api_key",
+		WithBaseURL("http://api.test.com"),
+		WithToken("token"),
+		WithLocale(LocaleFR),
+		WithRetry(DefaultRetryPolicy()),
+	)
+	assert.NoError(t, err)
+
+	member := client.WithToken("member_token").WithLocale(LocaleEN)
+
+	assert.Equal(t, "token", client.Token)
+	assert.Equal(t, LocaleFR, client.Locale)
+	assert.Equal(t, "member_token", member.Token)
+	assert.Equal(t, LocaleEN, member.Locale)
+	assert.Equal(t, member, member.Shows.client)
+	assert.Equal(t, client.httpClient, member.httpClient)
+	assert.Equal(t, client.Retry, member.Retry)
+
+	req, err := member.newRequest(context.Background(), "GET", "/test", ShowsListParams{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "http://api.test.com/test?locale=en", req.URL.String())
+	assert.Equal(t, "member_token", req.Header.Get("X-BetaSeries-Token"))
+}
+
+func TestClient_WithTokenConcurrent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token_"+r.URL.Query().Get("id"), r.Header.Get("X-BetaSeries-Token"))
+		_, _ = w.Write([]byte(`{"show": {"id": 1}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient("api_key", WithBaseURL(ts.URL))
 	assert.NoError(t, err)
 
-	client.baseURL = *apiURL
-	client.userAgent = "gotaseries-user-agent"
-	client.apiKey = "api_key"
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			member := client.WithToken(fmt.Sprintf("token_%d", id))
+			_, err := member.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(id)})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestNewRequestWithParams(t *testing.T) {
+	client, err := NewClient("api_key",
+		WithBaseURL("http://api.test.com"),
+		WithUserAgent("gotaseries-user-agent"),
+	)
+	assert.NoError(t, err)
 
 	req, err := client.newRequest(context.Background(), "GET", "/test", ShowsDisplayParams{ID: Int(1161)})
 	assert.NoError(t, err)
@@ -123,3 +233,201 @@ func TestNewRequestWithParams(t *testing.T) {
 	assert.Equal(t, "api_key", req.Header.Get("X-BetaSeries-Key"))
 	assert.Equal(t, "3.0", req.Header.Get("X-BetaSeries-Version"))
 }
+
+func TestNewRequestWithBody(t *testing.T) {
+	client, err := NewClient("api_key", WithBaseURL("http://api.test.com"))
+	assert.NoError(t, err)
+
+	text := strings.Repeat("Winter is coming & so is the night. ", 200)
+
+	testCases := []struct {
+		method string
+		body   bool
+	}{
+		{method: http.MethodGet, body: false},
+		{method: http.MethodPost, body: true},
+		{method: http.MethodPut, body: true},
+		{method: http.MethodDelete, body: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			req, err := client.newRequest(context.Background(), tc.method, "/comments/comment", CommentsPostParams{
+				Type: CommentTypeEpisode,
+				ID:   1,
+				Text: text,
+			})
+			assert.NoError(t, err)
+
+			expected := url.Values{"type": {"episode"}, "id": {"1"}, "text": {text}}.Encode()
+
+			if !tc.body {
+				assert.Equal(t, expected, req.URL.RawQuery)
+				assert.Empty(t, req.Header.Get("Content-Type"))
+				return
+			}
+
+			assert.Equal(t, "http://api.test.com/comments/comment", req.URL.String())
+			assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			assert.NotNil(t, req.GetBody)
+
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, string(body))
+		})
+	}
+}
+
+func TestDo(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    int
+		headers   map[string]string
+		body      string
+		httpError bool
+		apiError  bool
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			body:   `{"show": {"id": 1161}}`,
+		},
+		{
+			name:   "no content",
+			status: http.StatusNoContent,
+		},
+		{
+			name:     "api error",
+			status:   http.StatusBadRequest,
+			body:     `{"errors": [{"code": 4001, "text": "No series found."}]}`,
+			apiError: true,
+		},
+		{
+			name:      "html error page",
+			status:    http.StatusServiceUnavailable,
+			headers:   map[string]string{"Retry-After": "120"},
+			body:      "<html><body>Service Unavailable</body></html>",
+			httpError: true,
+		},
+		{
+			name:      "too many requests",
+			status:    http.StatusTooManyRequests,
+			headers:   map[string]string{"Retry-After": "3", "X-RateLimit-Limit": "100", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1712130000"},
+			body:      `{}`,
+			httpError: true,
+		},
+		{
+			name:      "empty error",
+			status:    http.StatusBadGateway,
+			httpError: true,
+		},
+		{
+			name:      "invalid json",
+			status:    http.StatusOK,
+			body:      "maintenance",
+			httpError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range tc.headers {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer ts.Close()
+
+			bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+			assert.NoError(t, err)
+
+			_, err = bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+
+			var httpErr *HTTPError
+			assert.Equal(t, tc.httpError, errors.As(err, &httpErr))
+
+			var apiErr *APIError
+			assert.Equal(t, tc.apiError, errors.As(err, &apiErr))
+
+			if !tc.httpError && !tc.apiError {
+				assert.NoError(t, err)
+			}
+
+			if tc.httpError {
+				assert.Equal(t, tc.status, httpErr.StatusCode)
+				assert.Equal(t, "/shows/display", httpErr.Path)
+				assert.Equal(t, tc.body, string(httpErr.Body))
+			}
+		})
+	}
+}
+
+func TestDoTooLarge(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"show": {"id": 1161, "description": "`))
+		_, _ = w.Write(bytes.Repeat([]byte("a"), MaxResponseSize))
+		_, _ = w.Write([]byte(`"}}`))
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+
+	var httpErr *HTTPError
+	assert.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusOK, httpErr.StatusCode)
+	assert.ErrorIs(t, err, ErrResponseTooLarge)
+}
+
+func TestDoHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1712130000")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(strings.Repeat("a", 1000)))
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+
+	var httpErr *HTTPError
+	assert.True(t, errors.As(err, &httpErr))
+	assert.True(t, httpErr.Temporary())
+	assert.Equal(t, 3*time.Second, httpErr.RetryAfter)
+	assert.Equal(t, Rate{Limit: 100, Remaining: 0, Reset: time.Unix(1712130000, 0)}, httpErr.Rate)
+	assert.Equal(t, 512, len(httpErr.Body))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 4, 3, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{value: "", expected: 0},
+		{value: "30", expected: 30 * time.Second},
+		{value: "-1", expected: 0},
+		{value: "Wed, 03 Apr 2024 10:01:00 GMT", expected: time.Minute},
+		{value: "Wed, 03 Apr 2024 09:00:00 GMT", expected: 0},
+		{value: "soon", expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			h := http.Header{}
+			h.Set("Retry-After", tc.value)
+
+			assert.Equal(t, tc.expected, parseRetryAfter(h, now))
+		})
+	}
+}
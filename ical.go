@@ -0,0 +1,174 @@
+package gotaseries
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icalProdID     = "-//gotaseries//gotaseries " + version + "//EN"
+	icalLineLength = 75
+)
+
+// ICalendarOptions configures the feed written by WriteICalendar.
+type ICalendarOptions struct {
+	// Name is the display name of the calendar (X-WR-CALNAME).
+	Name string
+	// Location is advertised as the calendar time zone with the non-standard
+	// X-WR-TIMEZONE property, no VTIMEZONE component is written. Events are
+	// all-day with floating dates, so the air date of an episode is never
+	// shifted whatever the time zone of the consumer. Defaults to UTC.
+	Location *time.Location
+	// Timestamp is the DTSTAMP of every event. Defaults to the current time.
+	Timestamp time.Time
+}
+
+// WriteICalendar writes episodes as an RFC 5545 iCalendar feed of all-day events.
+// Episodes without an air date are skipped. UIDs are derived from the episode ID so a feed can be regenerated without
+// creating duplicates in subscribed calendars.
+//
+// Example:
+//
+//	days, err := client.Planning.Member(ctx, gotaseries.PlanningMemberParams{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	var episodes []gotaseries.Episode
+//	for _, day := range days {
+//		episodes = append(episodes, day.Episodes...)
+//	}
+//	err = gotaseries.WriteICalendar(w, episodes, gotaseries.ICalendarOptions{Name: "BetaSeries"})
+func WriteICalendar(w io.Writer, episodes []Episode, opts ICalendarOptions) error {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	stamp := opts.Timestamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	bw := bufio.NewWriter(w)
+	iw := &icalWriter{w: bw}
+
+	iw.line("BEGIN:VCALENDAR")
+	iw.line("VERSION:2.0")
+	iw.line("PRODID:" + icalProdID)
+	iw.line("CALSCALE:GREGORIAN")
+	iw.line("METHOD:PUBLISH")
+	if opts.Name != "" {
+		iw.line("X-WR-CALNAME:" + escapeText(opts.Name))
+	}
+	iw.line("X-WR-TIMEZONE:" + loc.String())
+
+	for _, e := range episodes {
+		day := time.Time(e.Date)
+		if day.IsZero() {
+			continue
+		}
+
+		start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+		showTitle := e.Show.Title
+		if showTitle == "" {
+			showTitle = e.ShowSlug
+		}
+
+		iw.line("BEGIN:VEVENT")
+		iw.line(fmt.Sprintf("UID:episode-%d@betaseries.com", e.ID))
+		iw.line("DTSTAMP:" + stamp.UTC().Format("20060102T150405Z"))
+		iw.line("DTSTART;VALUE=DATE:" + start.Format("20060102"))
+		iw.line("DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format("20060102"))
+		iw.line("SUMMARY:" + escapeText(episodeSummary(showTitle, e)))
+		if e.Description != "" {
+			iw.line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		if showTitle != "" {
+			iw.line("CATEGORIES:" + escapeText(showTitle))
+		}
+		if e.ResourceURL != "" {
+			iw.line("URL:" + e.ResourceURL)
+		}
+		iw.line("TRANSP:TRANSPARENT")
+		iw.line("END:VEVENT")
+	}
+
+	iw.line("END:VCALENDAR")
+
+	if iw.err != nil {
+		return iw.err
+	}
+
+	return bw.Flush()
+}
+
+func episodeSummary(showTitle string, e Episode) string {
+	var parts []string
+	if showTitle != "" {
+		parts = append(parts, showTitle)
+	}
+	if e.Code != "" {
+		parts = append(parts, e.Code)
+	}
+
+	summary := strings.Join(parts, " ")
+	if e.Title != "" {
+		if summary != "" {
+			summary += " - "
+		}
+		summary += e.Title
+	}
+
+	return summary
+}
+
+// escapeText escapes a TEXT value as described in RFC 5545 section 3.3.11.
+func escapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return r.Replace(s)
+}
+
+type icalWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes a content line terminated by CRLF, folded at 75 octets without
+// splitting UTF-8 sequences as described in RFC 5545 section 3.1.
+func (iw *icalWriter) line(s string) {
+	if iw.err != nil {
+		return
+	}
+
+	limit := icalLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		iw.write(s[:cut] + "\r\n ")
+		s = s[cut:]
+		// The leading space of a continuation line counts toward its length.
+		limit = icalLineLength - 1
+	}
+
+	iw.write(s + "\r\n")
+}
+
+func (iw *icalWriter) write(s string) {
+	if iw.err != nil {
+		return
+	}
+	_, iw.err = iw.w.WriteString(s)
+}
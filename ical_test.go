@@ -0,0 +1,128 @@
+package gotaseries
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func icalEpisodes() []Episode {
+	hacks := Episode{
+		ID:          2901133,
+		Title:       "Chapter Three",
+		Code:        "S02E03",
+		Description: "Deborah and Ava hit the road; things get awkward, fast.",
+		ResourceURL: "https://www.betaseries.com/episode/hacks/s02e03",
+		Date:        Date(time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)),
+	}
+	hacks.Show.Title = "Hacks"
+
+	ripley := Episode{
+		ID:          2904990,
+		Title:       "La vérité",
+		Code:        "S01E04",
+		Description: "Tom Ripley, un escroc installé à New York au début des années 1960, est engagé par un riche industriel pour convaincre son fils vagabond de rentrer d'Italie.\nMais Tom se laisse séduire par la vie de Dickie; il veut plus.",
+		Date:        Date(time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)),
+	}
+	ripley.Show.Title = "Ripley"
+
+	mash := Episode{
+		ID:       1,
+		Title:    `Pilot \ Part 1`,
+		Code:     "S01E01",
+		ShowSlug: "mash",
+		Date:     Date(time.Date(1972, 9, 17, 0, 0, 0, 0, time.UTC)),
+	}
+	mash.Show.Title = "M*A*S*H, the series"
+
+	return []Episode{hacks, ripley, mash}
+}
+
+func TestWriteICalendar(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	// Episodes without an air date are skipped.
+	episodes := append(icalEpisodes(), Episode{ID: 3, Title: "TBA", Code: "S03E01"})
+
+	var b bytes.Buffer
+	err = WriteICalendar(&b, episodes, ICalendarOptions{
+		Name:      "Planning BetaSeries",
+		Location:  paris,
+		Timestamp: time.Date(2024, 4, 1, 10, 30, 0, 0, paris),
+	})
+	assert.NoError(t, err)
+
+	golden := "data/ical/planning.ics"
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, b.Bytes(), 0o644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(expected), b.String())
+}
+
+func TestWriteICalendarEmpty(t *testing.T) {
+	var b bytes.Buffer
+	err := WriteICalendar(&b, nil, ICalendarOptions{
+		Timestamp: time.Date(2024, 4, 1, 10, 30, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	golden := "data/ical/empty.ics"
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, b.Bytes(), 0o644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(expected), b.String())
+}
+
+func TestWriteICalendarFolding(t *testing.T) {
+	e := Episode{
+		ID:          42,
+		Description: strings.Repeat("é", 200),
+		Date:        Date(time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)),
+	}
+
+	var b bytes.Buffer
+	err := WriteICalendar(&b, []Episode{e}, ICalendarOptions{})
+	assert.NoError(t, err)
+
+	assert.True(t, strings.HasSuffix(b.String(), "\r\n"))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	var description string
+	for i, l := range lines {
+		assert.LessOrEqual(t, len(l), 75)
+		assert.True(t, utf8.ValidString(l))
+		if strings.HasPrefix(l, "DESCRIPTION:") {
+			description = strings.TrimPrefix(l, "DESCRIPTION:")
+			for _, next := range lines[i+1:] {
+				if !strings.HasPrefix(next, " ") {
+					break
+				}
+				description += strings.TrimPrefix(next, " ")
+			}
+		}
+	}
+
+	assert.Equal(t, e.Description, description)
+}
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne\nf`, escapeText("a\\b;c,d\ne\r\nf"))
+}
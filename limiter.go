@@ -0,0 +1,164 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// limiterMinFactor is the lowest fraction of the configured rate the
+	// limiter slows down to after repeated throttling errors.
+	limiterMinFactor = 1.0 / 16
+	// limiterRecoverFactor is the fraction of the configured rate recovered
+	// after each successful request.
+	limiterRecoverFactor = 1.0 / 10
+)
+
+// RateLimiter is a token bucket limiting the requests sent by a client. It is
+// safe for concurrent use and can be shared between several clients using the
+// same API key.
+//
+// The limiter slows down when Betaseries answers with a 429 status, halving its
+// rate and pausing until the delay of the Retry-After header is elapsed, then
+// recovers the configured rate as requests succeed.
+type RateLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	current float64
+	tokens  float64
+	last    time.Time
+	paused  time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rate requests per second, with
+// bursts of up to burst requests. A burst lower than 1 is set to 1 and a rate
+// of 0 or less does not limit requests.
+//
+// Example:
+//
+//	client, err := gotaseries.NewClient("YOUR_API_KEY", gotaseries.WithRateLimiter(gotaseries.NewRateLimiter(5, 10)))
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		current: rate,
+		tokens:  float64(burst),
+		last:    time.Now(),
+	}
+}
+
+// Limit returns the current rate of the limiter in requests per second, lower
+// than the configured one after throttling errors.
+func (l *RateLimiter) Limit() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.current
+}
+
+// Wait blocks until a request can be sent or the context is done, in which
+// case it returns the context error.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.advance(now)
+	l.tokens--
+
+	var d time.Duration
+	if l.tokens < 0 && l.current > 0 {
+		d = time.Duration(-l.tokens / l.current * float64(time.Second))
+	}
+	if p := l.paused.Sub(now); p > d {
+		d = p
+	}
+	l.mu.Unlock()
+
+	if err := sleep(ctx, d); err != nil {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// advance refills the bucket with the tokens accumulated since the last call.
+func (l *RateLimiter) advance(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.current
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+}
+
+// observe adapts the rate of the limiter to the outcome of a request.
+func (l *RateLimiter) observe(err error) {
+	if l == nil {
+		return
+	}
+
+	code, retryAfter, ok := responseStatus(err)
+	if ok && code == http.StatusTooManyRequests {
+		l.throttle(retryAfter)
+		return
+	}
+
+	if err == nil || ok && code < http.StatusBadRequest {
+		l.recover()
+	}
+}
+
+func (l *RateLimiter) throttle(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.advance(now)
+
+	l.current /= 2
+	if min := l.rate * limiterMinFactor; l.current < min {
+		l.current = min
+	}
+
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+
+	if until := now.Add(retryAfter); until.After(l.paused) {
+		l.paused = until
+	}
+}
+
+func (l *RateLimiter) recover() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.current >= l.rate {
+		return
+	}
+
+	l.advance(time.Now())
+
+	l.current += l.rate * limiterRecoverFactor
+	if l.current > l.rate {
+		l.current = l.rate
+	}
+}
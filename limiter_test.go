@@ -0,0 +1,129 @@
+package gotaseries
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	l := NewRateLimiter(20, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, l.Wait(context.Background()))
+	}
+
+	// The burst of 2 is immediate, the 2 next requests wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiter_Concurrent(t *testing.T) {
+	l := NewRateLimiter(100, 1)
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, l.Wait(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+}
+
+func TestRateLimiter_ContextCanceled(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := l.Wait(ctx)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// The canceled request gave its token back.
+	l.mu.Lock()
+	assert.InDelta(t, 0, l.tokens, 0.1)
+	l.mu.Unlock()
+}
+
+func TestRateLimiter_Nil(t *testing.T) {
+	var l *RateLimiter
+	assert.NoError(t, l.Wait(context.Background()))
+	l.observe(errors.New("error"))
+}
+
+func TestRateLimiter_Adaptive(t *testing.T) {
+	l := NewRateLimiter(10, 5)
+
+	l.observe(&HTTPError{StatusCode: http.StatusServiceUnavailable})
+	assert.Equal(t, float64(10), l.Limit())
+
+	l.observe(&HTTPError{StatusCode: http.StatusTooManyRequests})
+	assert.Equal(t, float64(5), l.Limit())
+
+	// A 429 is detected whether its body holds Betaseries errors or not.
+	l.observe(APIErrors{{Code: 0, StatusCode: http.StatusTooManyRequests}})
+	assert.Equal(t, 2.5, l.Limit())
+
+	for i := 0; i < 10; i++ {
+		l.observe(&HTTPError{StatusCode: http.StatusTooManyRequests})
+	}
+	assert.Equal(t, 10*limiterMinFactor, l.Limit())
+
+	for i := 0; i < 20; i++ {
+		l.observe(nil)
+	}
+	assert.Equal(t, float64(10), l.Limit())
+}
+
+func TestRateLimiter_RetryAfter(t *testing.T) {
+	l := NewRateLimiter(1000, 10)
+
+	l.observe(&HTTPError{StatusCode: http.StatusTooManyRequests, RetryAfter: 100 * time.Millisecond})
+
+	start := time.Now()
+	assert.NoError(t, l.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimiter_Client(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	})
+	defer ts.Close()
+
+	bc.Limiter = NewRateLimiter(50, 1)
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		// Distinct shows, identical requests would share a single call.
+		go func(id int) {
+			defer wg.Done()
+			_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(id)})
+			assert.NoError(t, err)
+		}(1161 + i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(6), atomic.LoadInt32(attempts))
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+}
@@ -0,0 +1,337 @@
+package gotaseries
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+)
+
+const (
+	OnlyShows  OnlyType = "shows"
+	OnlyMovies OnlyType = "movies"
+
+	OptionDownloaded OptionName = "downloaded"
+	OptionNotation   OptionName = "notation"
+	OptionTimelag    OptionName = "timelag"
+	OptionGlobal     OptionName = "global"
+	OptionSpecials   OptionName = "specials"
+	OptionFriendship OptionName = "friendship"
+)
+
+type MemberService Service
+
+type OnlyType string
+
+type OptionName string
+
+type memberResponse struct {
+	Member Member `json:"member"`
+	Errors Errors `json:"errors"`
+}
+
+type optionsResponse struct {
+	Options MemberOptions `json:"options"`
+	Errors  Errors        `json:"errors"`
+}
+
+type notificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+	Errors        Errors         `json:"errors"`
+}
+
+type MembersUsernameResponse struct {
+	Available bool     `json:"available"`
+	Usernames []string `json:"usernames"`
+	Errors    Errors   `json:"errors"`
+}
+
+type emailResponse struct {
+	Email  string `json:"email"`
+	Errors Errors `json:"errors"`
+}
+
+type avatarResponse struct {
+	Avatar string `json:"avatar"`
+	Errors Errors `json:"errors"`
+}
+
+type emptyResponse struct {
+	Errors Errors `json:"errors"`
+}
+
+// MembersAuthResponse is returned by a successful authentication.
+type MembersAuthResponse struct {
+	User   Member `json:"user"`
+	Token  string `json:"token"`
+	Hash   string `json:"hash"`
+	Errors Errors `json:"errors"`
+}
+
+type Member struct {
+	ID           int        `json:"id"`
+	FacebookID   *int       `json:"fb_id"`
+	Login        string     `json:"login"`
+	XP           int        `json:"xp"`
+	Locale       LocaleType `json:"locale"`
+	Cached       int64      `json:"cached"`
+	Avatar       *string    `json:"avatar"`
+	Banner       *string    `json:"profile_banner"`
+	InAccount    bool       `json:"in_account"`
+	Subscription *int       `json:"subscription"`
+	Stats        struct {
+		Friends             int     `json:"friends"`
+		Shows               int     `json:"shows"`
+		Seasons             int     `json:"seasons"`
+		Episodes            int     `json:"episodes"`
+		Comments            int     `json:"comments"`
+		Progress            float64 `json:"progress"`
+		EpisodesToWatch     int     `json:"episodes_to_watch"`
+		TimeOnTV            int     `json:"time_on_tv"`
+		TimeToSpend         int     `json:"time_to_spend"`
+		Movies              int     `json:"movies"`
+		Badges              int     `json:"badges"`
+		MemberSinceDays     int     `json:"member_since_days"`
+		FriendsOfFriends    int     `json:"friends_of_friends"`
+		EpisodesPerMonth    float64 `json:"episodes_per_month"`
+		FavoriteDay         string  `json:"favorite_day"`
+		FiveStarsPercent    float64 `json:"five_stars_percent"`
+		FourFiveStarsTotal  int     `json:"four_five_stars_total"`
+		StreakDays          int     `json:"streak_days"`
+		FavoriteGenre       string  `json:"favorite_genre"`
+		WrittenWords        int     `json:"written_words"`
+		WithoutDays         int     `json:"without_days"`
+		ShowsFinished       int     `json:"shows_finished"`
+		ShowsCurrent        int     `json:"shows_current"`
+		ShowsToWatch        int     `json:"shows_to_watch"`
+		ShowsAbandoned      int     `json:"shows_abandoned"`
+		MoviesToWatch       int     `json:"movies_to_watch"`
+		TimeOnMovies        int     `json:"time_on_movies"`
+		TimeToSpendOnMovies int     `json:"time_to_spend_movies"`
+	} `json:"stats"`
+	Options *MemberOptions `json:"options"`
+	Shows   []Show         `json:"shows"`
+	Movies  []Movie        `json:"movies"`
+}
+
+type MemberOptions struct {
+	Downloaded bool   `json:"downloaded"`
+	Notation   bool   `json:"notation"`
+	Timelag    bool   `json:"timelag"`
+	Global     bool   `json:"global"`
+	Specials   bool   `json:"specials"`
+	Friendship string `json:"friendship"`
+}
+
+type Notification struct {
+	ID    int      `json:"id"`
+	RefID string   `json:"ref_id"`
+	Type  string   `json:"type"`
+	Text  string   `json:"text"`
+	HTML  string   `json:"html"`
+	Date  DateTime `json:"date"`
+	Seen  bool     `json:"seen"`
+}
+
+type MembersAuthParams struct {
+	Login    string `url:"login"`
+	Password string `url:"password"`
+}
+
+type MembersIsActiveParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type MembersLogoutParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type MembersInfosParams struct {
+	ID      *int        `url:"id"`
+	Summary *bool       `url:"summary"`
+	Only    *OnlyType   `url:"only"`
+	Locale  *LocaleType `url:"locale"`
+}
+
+type MembersOptionsParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type MembersSetOptionParams struct {
+	Name  OptionName `url:"name"`
+	Value string     `url:"value"`
+}
+
+type MembersNotificationsParams struct {
+	SinceID    *int     `url:"since_id"`
+	Number     *int     `url:"number"`
+	Sort       *string  `url:"sort"`
+	Types      []string `url:"types"`
+	AutoDelete *bool    `url:"auto_delete"`
+}
+
+type MembersUsernameParams struct {
+	Login string `url:"login"`
+}
+
+type MembersEmailParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type MembersUpdateEmailParams struct {
+	Email string `url:"email"`
+}
+
+type MembersDeleteAvatarParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+// Auth authenticates a member with its login and the MD5 hash of its password.
+// The returned token is not stored on the client, see Login.
+func (m *MemberService) Auth(ctx context.Context, params MembersAuthParams) (*MembersAuthResponse, error) {
+	var res MembersAuthResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/members/auth", params, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Login authenticates a member with its login and plain text password and stores the returned token on the client.
+// On a client shared between several members, call Login on a copy returned by Client.WithToken.
+//
+// Example:
+//
+//	member, err := client.Members.Login(context.Background(), "login", "password")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s is logged in\n", member.Login)
+func (m *MemberService) Login(ctx context.Context, login, password string) (*Member, error) {
+	hash := md5.Sum([]byte(password))
+
+	res, err := m.Auth(ctx, MembersAuthParams{
+		Login:    login,
+		Password: hex.EncodeToString(hash[:]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.client.Token = res.Token
+
+	return &res.User, nil
+}
+
+// Logout destroys the active token and removes it from the client.
+// Require a valid token.
+func (m *MemberService) Logout(ctx context.Context, params MembersLogoutParams) error {
+	var res emptyResponse
+	if err := m.client.doRequest(ctx, http.MethodDelete, "/members/destroy", params, &res); err != nil {
+		return err
+	}
+
+	m.client.Token = ""
+
+	return nil
+}
+
+// IsActive checks whether the token of the client is still active. An invalid
+// token returns false without error, an error is only returned when the check
+// itself fails, e.g. on a network error.
+func (m *MemberService) IsActive(ctx context.Context, params MembersIsActiveParams) (bool, error) {
+	var res emptyResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/members/is_active", params, &res); err != nil {
+		if errors.Is(err, ErrUnauthorized) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Infos returns information about the authenticated member or ID member. (ID member has priority over token)
+func (m *MemberService) Infos(ctx context.Context, params MembersInfosParams) (*Member, error) {
+	var res memberResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/members/infos", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Member, nil
+}
+
+// Options returns the options of the authenticated member.
+// Require a valid token.
+func (m *MemberService) Options(ctx context.Context, params MembersOptionsParams) (*MemberOptions, error) {
+	var res optionsResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/members/options", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Options, nil
+}
+
+// SetOption update an option of the authenticated member.
+// Require a valid token.
+func (m *MemberService) SetOption(ctx context.Context, params MembersSetOptionParams) (*MemberOptions, error) {
+	var res optionsResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/members/option", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Options, nil
+}
+
+// Notifications returns the latest notifications of the authenticated member.
+// Require a valid token.
+func (m *MemberService) Notifications(ctx context.Context, params MembersNotificationsParams) ([]Notification, error) {
+	var res notificationsResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/members/notifications", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Notifications, nil
+}
+
+// Username checks whether a login is available and returns suggestions when it is not.
+func (m *MemberService) Username(ctx context.Context, params MembersUsernameParams) (*MembersUsernameResponse, error) {
+	var res MembersUsernameResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/members/username", params, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Email returns the email address of the authenticated member.
+// Require a valid token.
+func (m *MemberService) Email(ctx context.Context, params MembersEmailParams) (string, error) {
+	var res emailResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/members/email", params, &res); err != nil {
+		return "", err
+	}
+	return res.Email, nil
+}
+
+// UpdateEmail change the email address of the authenticated member.
+// Require a valid token.
+func (m *MemberService) UpdateEmail(ctx context.Context, params MembersUpdateEmailParams) (string, error) {
+	var res emailResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/members/email", params, &res); err != nil {
+		return "", err
+	}
+	return res.Email, nil
+}
+
+// UpdateAvatar uploads and replaces the avatar of the authenticated member. It returns the new avatar URL.
+// Require a valid token.
+func (m *MemberService) UpdateAvatar(ctx context.Context, filename string, avatar io.Reader) (string, error) {
+	var res avatarResponse
+	if err := m.client.doUpload(ctx, "/members/avatar", "avatar", filename, avatar, &res); err != nil {
+		return "", err
+	}
+	return res.Avatar, nil
+}
+
+// DeleteAvatar deletes the avatar of the authenticated member.
+// Require a valid token.
+func (m *MemberService) DeleteAvatar(ctx context.Context, params MembersDeleteAvatarParams) error {
+	var res emptyResponse
+	return m.client.doRequest(ctx, http.MethodDelete, "/members/avatar", params, &res)
+}
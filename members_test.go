@@ -0,0 +1,255 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberService_Login(t *testing.T) {
+	data, err := os.ReadFile("data/members/auth.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "members/auth?login=Dev051&password=5f4dcc3b5aa765d61d8327deb882cf99"), string(data))
+	defer ts.Close()
+
+	member, err := bc.Members.Login(context.Background(), "Dev051", "password")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, member.ID)
+	assert.Equal(t, "Dev051", member.Login)
+	assert.Equal(t, "bdf0f2ff8b2c", bc.Token)
+}
+
+func TestMemberService_LoginInvalidPassword(t *testing.T) {
+	data, err := os.ReadFile("data/members/auth_invalid.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "members/auth?login=Dev051&password=5f4dcc3b5aa765d61d8327deb882cf99"), string(data))
+	defer ts.Close()
+
+	_, err = bc.Members.Login(context.Background(), "Dev051", "password")
+	assert.Error(t, err)
+
+	assert.Equal(t, err.Error(), "Code: 4003, Message: Mot de passe invalide.\n")
+	assert.Equal(t, "", bc.Token)
+}
+
+func TestMemberService_Logout(t *testing.T) {
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "members/destroy"), `{"errors": []}`)
+	defer ts.Close()
+
+	bc.Token = "bdf0f2ff8b2c"
+
+	err := bc.Members.Logout(context.Background(), MembersLogoutParams{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", bc.Token)
+}
+
+func TestMemberService_IsActive(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     string
+		expected bool
+		err      bool
+	}{
+		{
+			name:     "active",
+			data:     `{"errors": []}`,
+			expected: true,
+		},
+		{
+			name:     "inactive",
+			data:     `{"errors": [{"code": 2001, "text": "Invalid token."}]}`,
+			expected: false,
+		},
+		{
+			name:     "invalid api key",
+			data:     `{"errors": [{"code": 1001, "text": "Invalid API key."}]}`,
+			expected: false,
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "members/is_active"), tc.data)
+			defer ts.Close()
+
+			active, err := bc.Members.IsActive(context.Background(), MembersIsActiveParams{})
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, active)
+		})
+	}
+}
+
+func TestMemberService_IsActiveNetworkError(t *testing.T) {
+	ts, bc := setup(t, "GET", "/members/is_active", `{"errors": []}`)
+	ts.Close()
+
+	active, err := bc.Members.IsActive(context.Background(), MembersIsActiveParams{})
+	assert.Error(t, err)
+	assert.False(t, active)
+}
+
+func TestMemberService_Infos(t *testing.T) {
+	data, err := os.ReadFile("data/members/infos.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "members/infos?id=1&only=shows&summary=true"), string(data))
+	defer ts.Close()
+
+	member, err := bc.Members.Infos(context.Background(), MembersInfosParams{
+		ID:      Int(1),
+		Summary: Bool(true),
+		Only:    Only(OnlyShows),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Dev051", member.Login)
+	assert.Equal(t, LocaleFR, member.Locale)
+	assert.Nil(t, member.FacebookID)
+	assert.Equal(t, 154, member.Stats.Shows)
+	assert.Equal(t, "Drame", member.Stats.FavoriteGenre)
+	assert.Equal(t, "open", member.Options.Friendship)
+}
+
+func TestMemberService_Options(t *testing.T) {
+	data, err := os.ReadFile("data/members/options.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "members/options"), string(data))
+	defer ts.Close()
+
+	options, err := bc.Members.Options(context.Background(), MembersOptionsParams{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, options.Downloaded)
+	assert.Equal(t, false, options.Timelag)
+	assert.Equal(t, true, options.Specials)
+}
+
+func TestMemberService_SetOption(t *testing.T) {
+	data, err := os.ReadFile("data/members/options.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "members/option?name=specials&value=1"), string(data))
+	defer ts.Close()
+
+	options, err := bc.Members.SetOption(context.Background(), MembersSetOptionParams{
+		Name:  OptionSpecials,
+		Value: "1",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, options.Specials)
+}
+
+func TestMemberService_Notifications(t *testing.T) {
+	data, err := os.ReadFile("data/members/notifications.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "members/notifications?number=2&types=episode%2Cfriend"), string(data))
+	defer ts.Close()
+
+	notifications, err := bc.Members.Notifications(context.Background(), MembersNotificationsParams{
+		Number: Int(2),
+		Types:  []string{"episode", "friend"},
+	})
+	assert.NoError(t, err)
+
+	d, err := time.Parse("2006-01-02 15:04:05", "2019-05-13 08:00:12")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(notifications))
+	assert.Equal(t, "episode", notifications[0].Type)
+	assert.Equal(t, DateTime(d), notifications[0].Date)
+	assert.Equal(t, false, notifications[0].Seen)
+	assert.Equal(t, true, notifications[1].Seen)
+}
+
+func TestMemberService_Username(t *testing.T) {
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "members/username?login=Dev051"), `{"available": false, "usernames": ["Dev0512", "Dev051_"]}`)
+	defer ts.Close()
+
+	res, err := bc.Members.Username(context.Background(), MembersUsernameParams{
+		Login: "Dev051",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, false, res.Available)
+	assert.Equal(t, []string{"Dev0512", "Dev051_"}, res.Usernames)
+}
+
+func TestMemberService_Email(t *testing.T) {
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "members/email"), `{"email": "dev051@example.com"}`)
+	defer ts.Close()
+
+	email, err := bc.Members.Email(context.Background(), MembersEmailParams{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "dev051@example.com", email)
+}
+
+func TestMemberService_UpdateEmail(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "members/email?email=new%40example.com"), `{"email": "new@example.com"}`)
+	defer ts.Close()
+
+	email, err := bc.Members.UpdateEmail(context.Background(), MembersUpdateEmailParams{
+		Email: "new@example.com",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "new@example.com", email)
+}
+
+func TestMemberService_UpdateAvatar(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/members/avatar", r.URL.String())
+
+		file, header, err := r.FormFile("avatar")
+		assert.NoError(t, err)
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "avatar.png", header.Filename)
+		assert.Equal(t, "png content", string(content))
+		assert.Equal(t, "en", r.FormValue("locale"))
+
+		_, _ = w.Write([]byte(`{"avatar": "https://pictures.betaseries.com/avatar/1.png"}`))
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL), WithLocale(LocaleEN))
+	assert.NoError(t, err)
+
+	avatar, err := bc.Members.UpdateAvatar(context.Background(), "avatar.png", strings.NewReader("png content"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://pictures.betaseries.com/avatar/1.png", avatar)
+}
+
+func TestMemberService_DeleteAvatar(t *testing.T) {
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "members/avatar"), `{"errors": []}`)
+	defer ts.Close()
+
+	err := bc.Members.DeleteAvatar(context.Background(), MembersDeleteAvatarParams{})
+	assert.NoError(t, err)
+}
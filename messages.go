@@ -0,0 +1,133 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+)
+
+type MessageService Service
+
+type messagesResponse struct {
+	Messages []Message `json:"messages"`
+	Errors   Errors    `json:"errors"`
+}
+
+type messageResponse struct {
+	Message Message `json:"message"`
+	Errors  Errors  `json:"errors"`
+}
+
+type Message struct {
+	ID        int           `json:"id"`
+	MessageID int           `json:"message_id"`
+	Owner     MemberSummary `json:"owner"`
+	Recipient MemberSummary `json:"recipient"`
+	Date      DateTime      `json:"date"`
+	Title     string        `json:"title"`
+	Text      string        `json:"text"`
+	Unread    bool          `json:"unread"`
+	HasUnread bool          `json:"has_unread"`
+	Replies   int           `json:"replies"`
+}
+
+// Discussion is a thread of messages sharing the same first message.
+type Discussion struct {
+	ID       int
+	Messages []Message
+}
+
+// Unread returns the number of unread messages of the discussion.
+func (d *Discussion) Unread() int {
+	return UnreadCount(d.Messages)
+}
+
+// UnreadCount returns the number of unread messages. In an inbox, a discussion
+// with unread replies counts once.
+func UnreadCount(messages []Message) int {
+	count := 0
+	for _, m := range messages {
+		if m.Unread || m.HasUnread {
+			count++
+		}
+	}
+	return count
+}
+
+type MessagesInboxParams struct {
+	PerPage *int        `url:"nbpp"`
+	Page    *int        `url:"page"`
+	Locale  *LocaleType `url:"locale"`
+}
+
+type MessagesDiscussionParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MessagesSendParams struct {
+	ID     *int        `url:"id"`
+	To     *int        `url:"to"`
+	Title  *string     `url:"title"`
+	Text   string      `url:"text"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MessagesDeleteParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MessagesReadParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+// Inbox returns the first message of each discussion of the authenticated member.
+// Require a valid token.
+func (m *MessageService) Inbox(ctx context.Context, params MessagesInboxParams) ([]Message, error) {
+	var res messagesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/messages/inbox", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Messages, nil
+}
+
+// Discussion returns all the messages of a discussion.
+// Require a valid token.
+func (m *MessageService) Discussion(ctx context.Context, params MessagesDiscussionParams) (*Discussion, error) {
+	var res messagesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/messages/discussion", params, &res); err != nil {
+		return nil, err
+	}
+	return &Discussion{ID: params.ID, Messages: res.Messages}, nil
+}
+
+// Send send a new message to a member, or reply to a discussion when ID is set.
+// Require a valid token.
+func (m *MessageService) Send(ctx context.Context, params MessagesSendParams) (*Message, error) {
+	var res messageResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/messages/message", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Message, nil
+}
+
+// Delete delete a message.
+// Require a valid token.
+func (m *MessageService) Delete(ctx context.Context, params MessagesDeleteParams) (*Message, error) {
+	var res messageResponse
+	if err := m.client.doRequest(ctx, http.MethodDelete, "/messages/message", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Message, nil
+}
+
+// Read mark a message as read.
+// Require a valid token.
+func (m *MessageService) Read(ctx context.Context, params MessagesReadParams) (*Message, error) {
+	var res messageResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/messages/read", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Message, nil
+}
@@ -0,0 +1,88 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageService_Inbox(t *testing.T) {
+	data, err := os.ReadFile("data/messages/inbox.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "messages/inbox?nbpp=2&page=1"), string(data))
+	defer ts.Close()
+
+	messages, err := bc.Messages.Inbox(context.Background(), MessagesInboxParams{
+		PerPage: Int(2),
+		Page:    Int(1),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(messages))
+	assert.Equal(t, "test user", messages[0].Owner.Login)
+	assert.Equal(t, "Dev051", messages[0].Recipient.Login)
+	assert.Equal(t, true, messages[0].HasUnread)
+	assert.Equal(t, 3, messages[0].Replies)
+	assert.Equal(t, 1, UnreadCount(messages))
+}
+
+func TestMessageService_Discussion(t *testing.T) {
+	data, err := os.ReadFile("data/messages/discussion.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "messages/discussion?id=412331"), string(data))
+	defer ts.Close()
+
+	discussion, err := bc.Messages.Discussion(context.Background(), MessagesDiscussionParams{
+		ID: 412331,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 412331, discussion.ID)
+	assert.Equal(t, 3, len(discussion.Messages))
+	assert.Equal(t, "Alors ?", discussion.Messages[2].Text)
+	assert.Equal(t, 1, discussion.Unread())
+}
+
+func TestMessageService_Send(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "messages/message?text=Salut&title=Hello&to=9876"), `{"message": {"id": 412400, "message_id": 412400, "title": "Hello", "text": "Salut"}}`)
+	defer ts.Close()
+
+	message, err := bc.Messages.Send(context.Background(), MessagesSendParams{
+		To:    Int(9876),
+		Title: String("Hello"),
+		Text:  "Salut",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 412400, message.ID)
+	assert.Equal(t, "Salut", message.Text)
+}
+
+func TestMessageService_Delete(t *testing.T) {
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "messages/message?id=412400"), `{"message": {"id": 412400}}`)
+	defer ts.Close()
+
+	message, err := bc.Messages.Delete(context.Background(), MessagesDeleteParams{
+		ID: 412400,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 412400, message.ID)
+}
+
+func TestMessageService_Read(t *testing.T) {
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "messages/read?id=412355"), `{"message": {"id": 412355, "unread": false}}`)
+	defer ts.Close()
+
+	message, err := bc.Messages.Read(context.Background(), MessagesReadParams{
+		ID: 412355,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, false, message.Unread)
+}
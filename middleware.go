@@ -0,0 +1,237 @@
+package gotaseries
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Middleware hooks into the requests sent by a client, see WithMiddleware.
+type Middleware interface {
+	// BeforeSend is called before each attempt to send a request. It returns the
+	// request to send, which can be req itself or a copy with another context.
+	// A non-nil error aborts the call.
+	BeforeSend(req *http.Request) (*http.Request, error)
+	// AfterReceive is called after each attempt with the response, nil when
+	// none was received, and the error returned for the attempt: an
+	// *HTTPError, the APIErrors of the response or a network error. The
+	// body of the response can be read again.
+	AfterReceive(req *http.Request, res *http.Response, err error)
+}
+
+// BeforeSendFunc is a Middleware only hooking before a request is sent.
+type BeforeSendFunc func(req *http.Request) (*http.Request, error)
+
+func (f BeforeSendFunc) BeforeSend(req *http.Request) (*http.Request, error) {
+	return f(req)
+}
+
+func (f BeforeSendFunc) AfterReceive(*http.Request, *http.Response, error) {}
+
+// AfterReceiveFunc is a Middleware only hooking after a response is received.
+type AfterReceiveFunc func(req *http.Request, res *http.Response, err error)
+
+func (f AfterReceiveFunc) BeforeSend(req *http.Request) (*http.Request, error) {
+	return req, nil
+}
+
+func (f AfterReceiveFunc) AfterReceive(req *http.Request, res *http.Response, err error) {
+	f(req, res, err)
+}
+
+// beforeSend runs the BeforeSend hooks in the order the middlewares were added.
+func (c *Client) beforeSend(req *http.Request) (*http.Request, error) {
+	for _, m := range c.middlewares {
+		r, err := m.BeforeSend(req)
+		if err != nil {
+			return nil, err
+		}
+		req = r
+	}
+	return req, nil
+}
+
+// afterReceive runs the AfterReceive hooks in the reverse order.
+func (c *Client) afterReceive(req *http.Request, res *http.Response, err error) {
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		c.middlewares[i].AfterReceive(req, res, err)
+	}
+}
+
+// HeaderMiddleware returns a middleware setting the given headers on each request.
+func HeaderMiddleware(header http.Header) Middleware {
+	return BeforeSendFunc(func(req *http.Request) (*http.Request, error) {
+		for k, v := range header {
+			req.Header[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+		}
+		return req, nil
+	})
+}
+
+type startKey struct{}
+
+type loggingMiddleware struct {
+	logger Logger
+}
+
+// LoggingMiddleware returns a middleware logging the method, URL, status and
+// duration of each request.
+func LoggingMiddleware(logger Logger) Middleware {
+	return loggingMiddleware{logger: logger}
+}
+
+func (m loggingMiddleware) BeforeSend(req *http.Request) (*http.Request, error) {
+	return req.WithContext(context.WithValue(req.Context(), startKey{}, time.Now())), nil
+}
+
+func (m loggingMiddleware) AfterReceive(req *http.Request, res *http.Response, err error) {
+	var elapsed time.Duration
+	if start, ok := req.Context().Value(startKey{}).(time.Time); ok {
+		elapsed = time.Since(start)
+	}
+
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+	}
+
+	if err != nil {
+		m.logger.Printf("gotaseries: %s %s %d in %s: %v", req.Method, requestURI(req.URL), status, elapsed, err)
+		return
+	}
+
+	m.logger.Printf("gotaseries: %s %s %d in %s", req.Method, requestURI(req.URL), status, elapsed)
+}
+
+type dumpMiddleware struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// DumpMiddleware returns a middleware writing each request and response to w,
+// bodies included. The API key, the token and the other secrets sent as
+// parameters, like a password, are redacted from the headers, the query string
+// and the form bodies.
+func DumpMiddleware(w io.Writer) Middleware {
+	return &dumpMiddleware{w: w}
+}
+
+func (m *dumpMiddleware) BeforeSend(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	r.Body = nil
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+
+		if r.Header.Get("Content-Type") == formContentType {
+			if err := redactForm(r); err != nil {
+				return nil, err
+			}
+		}
+	}
+	redact(r.Header)
+	if r.URL.RawQuery != "" {
+		r.URL.RawQuery = sanitizeQuery(r.URL)
+	}
+
+	dump, err := httputil.DumpRequestOut(r, true)
+	if err != nil {
+		return nil, err
+	}
+
+	m.write(dump)
+
+	return req, nil
+}
+
+func (m *dumpMiddleware) AfterReceive(req *http.Request, res *http.Response, err error) {
+	if res == nil {
+		m.write([]byte(fmt.Sprintf("%v\n\n", err)))
+		return
+	}
+
+	dump, derr := httputil.DumpResponse(res, true)
+	if derr != nil {
+		return
+	}
+
+	m.write(dump)
+}
+
+func (m *dumpMiddleware) write(dump []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, _ = m.w.Write(dump)
+	_, _ = io.WriteString(m.w, "\n\n")
+}
+
+// redacted replaces the credentials in headers and query strings.
+const redacted = "REDACTED"
+
+// redact hides the credentials of the headers of a request.
+func redact(h http.Header) {
+	for _, k := range []string{"X-BetaSeries-Key", "X-BetaSeries-Token"} {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+}
+
+// secretParams are the parameters holding credentials: the API key and the
+// token, which Betaseries also accepts as parameters, the password of
+// MemberService.Auth and the ones of the OAuth token exchange.
+var secretParams = []string{"key", "token", "access_token", "password", "client_secret", "code"}
+
+// redactValues hides the credentials of the parameters of a request.
+func redactValues(q url.Values) {
+	for _, k := range secretParams {
+		if _, ok := q[k]; ok {
+			q.Set(k, redacted)
+		}
+	}
+}
+
+// sanitizeQuery returns the query string of u with the credentials redacted.
+func sanitizeQuery(u *url.URL) string {
+	q := u.Query()
+	redactValues(q)
+	return q.Encode()
+}
+
+// redactForm replaces the form body of r by a copy with the credentials
+// redacted.
+func redactForm(r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	if q, err := url.ParseQuery(string(body)); err == nil {
+		redactValues(q)
+		body = []byte(q.Encode())
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+
+	return nil
+}
+
+// requestURI returns the path and the sanitized query string of u.
+func requestURI(u *url.URL) string {
+	if q := sanitizeQuery(u); q != "" {
+		return u.EscapedPath() + "?" + q
+	}
+	return u.EscapedPath()
+}
@@ -0,0 +1,182 @@
+package gotaseries
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordMiddleware struct {
+	name  string
+	calls *[]string
+}
+
+func (m recordMiddleware) BeforeSend(req *http.Request) (*http.Request, error) {
+	*m.calls = append(*m.calls, "before "+m.name)
+	return req, nil
+}
+
+func (m recordMiddleware) AfterReceive(req *http.Request, res *http.Response, err error) {
+	*m.calls = append(*m.calls, "after "+m.name)
+}
+
+func TestMiddleware_Order(t *testing.T) {
+	ts, bc := setup(t, "GET", "/shows/display?id=1161", `{"show": {"id": 1161}}`)
+	defer ts.Close()
+
+	var calls []string
+	bc.middlewares = []Middleware{
+		recordMiddleware{name: "first", calls: &calls},
+		recordMiddleware{name: "second", calls: &calls},
+	}
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"before first", "before second", "after second", "after first"}, calls)
+}
+
+func TestMiddleware_Retried(t *testing.T) {
+	ts, bc, _ := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	})
+	defer ts.Close()
+
+	var statuses []int
+	bc.middlewares = []Middleware{AfterReceiveFunc(func(req *http.Request, res *http.Response, err error) {
+		statuses = append(statuses, res.StatusCode)
+	})}
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{http.StatusBadGateway, http.StatusOK}, statuses)
+}
+
+func TestMiddleware_BeforeSendError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	}))
+	defer ts.Close()
+
+	abort := errors.New("audit refused")
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL), WithMiddleware(BeforeSendFunc(func(req *http.Request) (*http.Request, error) {
+		return nil, abort
+	})))
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.Equal(t, abort, err)
+}
+
+func TestMiddleware_AfterReceive(t *testing.T) {
+	body := `{"errors": [{"code": 4001, "text": "No series found."}]}`
+	ts, bc := setup(t, "GET", "/shows/display?id=1", body)
+	defer ts.Close()
+
+	var got []byte
+	var gotErr error
+	bc.middlewares = []Middleware{AfterReceiveFunc(func(req *http.Request, res *http.Response, err error) {
+		got, _ = io.ReadAll(res.Body)
+		gotErr = err
+	})}
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1)})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.Equal(t, body, string(got))
+	assert.ErrorIs(t, gotErr, ErrNotFound)
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "audit-42", r.Header.Get("X-Audit-Id"))
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL), WithMiddleware(HeaderMiddleware(http.Header{"x-audit-id": {"audit-42"}})))
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	ts, bc := setup(t, "GET", "/shows/display?id=1161", `{"show": {"id": 1161}}`)
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	bc.middlewares = []Middleware{LoggingMiddleware(log.New(&buf, "", 0))}
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Regexp(t, `^gotaseries: GET /shows/display\?id=1161 200 in \S+\n$`, buf.String())
+}
+
+func TestDumpMiddleware(t *testing.T) {
+	ts, bc := setup(t, "POST", "/comments/comment?id=1&text=Winter+is+coming&type=episode", `{"comment": {"id": 1}}`)
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	bc.Token = "secret_token"
+	bc.middlewares = []Middleware{DumpMiddleware(&buf)}
+
+	_, err := bc.Comments.Post(context.Background(), CommentsPostParams{Type: CommentTypeEpisode, ID: 1, Text: "Winter is coming"})
+	assert.NoError(t, err)
+
+	dump := buf.String()
+	assert.Contains(t, dump, "POST /comments/comment HTTP/1.1")
+	assert.Contains(t, dump, "id=1&text=Winter+is+coming&type=episode")
+	assert.Contains(t, dump, "X-Betaseries-Key: REDACTED")
+	assert.Contains(t, dump, "X-Betaseries-Token: REDACTED")
+	assert.NotContains(t, dump, "api_key")
+	assert.NotContains(t, dump, "secret_token")
+	assert.Contains(t, dump, "HTTP/1.1 200 OK")
+	assert.Contains(t, dump, `{"comment": {"id": 1}}`)
+}
+
+func TestDumpMiddleware_Redacted(t *testing.T) {
+	ts, bc := setup(t, "POST", "/members/auth?login=login&password=secret_password", `{"token": "secret_token"}`)
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	bc.middlewares = []Middleware{DumpMiddleware(&buf)}
+
+	_, err := bc.Members.Auth(context.Background(), MembersAuthParams{Login: "login", Password: "secret_password"})
+	assert.NoError(t, err)
+
+	dump := buf.String()
+	assert.Contains(t, dump, "login=login&password=REDACTED")
+	assert.NotContains(t, dump, "secret_password")
+
+	ts, bc = setup(t, "GET", "/shows/display?code=secret_code&id=1161&token=secret_token", `{"show": {"id": 1161}}`)
+	defer ts.Close()
+
+	buf.Reset()
+	bc.middlewares = []Middleware{DumpMiddleware(&buf)}
+
+	var res showResponse
+	err = bc.doRequest(context.Background(), http.MethodGet, "/shows/display", struct {
+		ID    int    `url:"id"`
+		Code  string `url:"code"`
+		Token string `url:"token"`
+	}{ID: 1161, Code: "secret_code", Token: "secret_token"}, &res)
+	assert.NoError(t, err)
+
+	dump = buf.String()
+	assert.Contains(t, dump, "GET /shows/display?code=REDACTED&id=1161&token=REDACTED HTTP/1.1")
+	assert.NotContains(t, dump, "secret_code")
+}
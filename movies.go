@@ -0,0 +1,381 @@
+package gotaseries
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+const (
+	MovieStateToSee    MovieStateType = 0
+	MovieStateSeen     MovieStateType = 1
+	MovieStateNotToSee MovieStateType = 2
+
+	DiscoverMoviePopular  DiscoverMovieType = "popular"
+	DiscoverMovieUpcoming DiscoverMovieType = "upcoming"
+	DiscoverMoviePositive DiscoverMovieType = "positive"
+	DiscoverMovieBlogs    DiscoverMovieType = "blogs"
+)
+
+type MovieService Service
+
+type MovieStateType int
+
+func (ms *MovieStateType) IsValid() error {
+	switch *ms {
+	case MovieStateToSee, MovieStateSeen, MovieStateNotToSee:
+		return nil
+	}
+	return errors.New("invalid MovieStateType")
+}
+
+type DiscoverMovieType string
+
+type moviesResponse struct {
+	Movies []Movie `json:"movies"`
+	Errors Errors  `json:"errors"`
+}
+
+type movieResponse struct {
+	Movie  Movie  `json:"movie"`
+	Errors Errors `json:"errors"`
+}
+
+type MoviesMemberResponse struct {
+	Movies []Movie `json:"movies"`
+	Total  int     `json:"total"`
+	Errors Errors  `json:"errors"`
+}
+
+type Movie struct {
+	ID             int     `json:"id"`
+	MoviedbID      int     `json:"tmdb_id"`
+	ImdbID         string  `json:"imdb_id"`
+	URL            string  `json:"url"`
+	Title          string  `json:"title"`
+	OriginalTitle  string  `json:"original_title"`
+	OtherTitle     *string `json:"other_title"`
+	Tagline        string  `json:"tagline"`
+	Synopsis       string  `json:"synopsis"`
+	Director       string  `json:"director"`
+	Length         int     `json:"length"`
+	ReleaseDate    string  `json:"release_date"`
+	SaleDate       *string `json:"sale_date"`
+	ProductionYear int     `json:"production_year"`
+	Language       string  `json:"language"`
+	Backdrop       string  `json:"backdrop"`
+	Poster         string  `json:"poster"`
+	Followers      int     `json:"followers"`
+	Comments       int     `json:"comments"`
+	Similars       int     `json:"similars"`
+	Characters     int     `json:"characters"`
+	Genres         Genres  `json:"genres"`
+	Note           Note    `json:"notes"`
+	User           struct {
+		InAccount bool           `json:"in_account"`
+		Status    MovieStateType `json:"status"`
+		Favorited bool           `json:"favorited"`
+		Tags      Tags           `json:"tags"`
+	} `json:"user"`
+	ResourceURL string     `json:"resource_url"`
+	Platforms   *Platforms `json:"platforms"`
+}
+
+type MoviesDisplayParams struct {
+	ID     *int        `url:"id"`
+	ImdbID *string     `url:"imdb_id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesListParams struct {
+	Order  *OrderType  `url:"order"`
+	Start  *int        `url:"start"`
+	Limit  *int        `url:"limit"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesSearchParams struct {
+	Title   *string     `url:"title"`
+	Order   *OrderType  `url:"order"`
+	PerPage *int        `url:"nbpp"`
+	Page    *int        `url:"page"`
+	Locale  *LocaleType `url:"locale"`
+}
+
+type MoviesRandomParams struct {
+	Number *int        `url:"nb"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesDiscoverParams struct {
+	Type   *DiscoverMovieType `url:"type"`
+	Limit  *int               `url:"limit"`
+	Offset *int               `url:"offset"`
+	Locale *LocaleType        `url:"locale"`
+}
+
+type MoviesFavoritesParams struct {
+	ID     *int        `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesAddFavoriteParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesDeleteFavoriteParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesMemberParams struct {
+	ID     *int            `url:"id"`
+	State  *MovieStateType `url:"state"`
+	Order  *OrderType      `url:"order"`
+	Start  *int            `url:"start"`
+	Limit  *int            `url:"limit"`
+	Locale *LocaleType     `url:"locale"`
+}
+
+type MoviesAddParams struct {
+	ID      int             `url:"id"`
+	State   *MovieStateType `url:"state"`
+	Mail    *bool           `url:"mail"`
+	Twitter *bool           `url:"twitter"`
+	Profile *bool           `url:"profile"`
+	Locale  *LocaleType     `url:"locale"`
+}
+
+type MoviesDeleteParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesAddNoteParams struct {
+	ID     int         `url:"id"`
+	Note   int         `url:"note"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesDeleteNoteParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesGenresParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesCharactersParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesSimilarsParams struct {
+	ID      int         `url:"id"`
+	Details *bool       `url:"details"`
+	Locale  *LocaleType `url:"locale"`
+}
+
+type MoviesVideosParams struct {
+	ID     int         `url:"id"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type MoviesScraperParams struct {
+	File   string      `url:"file"`
+	Locale *LocaleType `url:"locale"`
+}
+
+// Display returns information about a movie.
+func (m *MovieService) Display(ctx context.Context, params MoviesDisplayParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/movie", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// List returns a list of all movies.
+func (m *MovieService) List(ctx context.Context, params MoviesListParams) ([]Movie, error) {
+	var res moviesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/list", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Movies, nil
+}
+
+// Search returns a list of movies matching the search query.
+func (m *MovieService) Search(ctx context.Context, params MoviesSearchParams) ([]Movie, error) {
+	var res moviesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/search", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Movies, nil
+}
+
+// SearchPager returns a pager over the movies matching the search, see Search.
+func (m *MovieService) SearchPager(params MoviesSearchParams) *Pager[Movie] {
+	return newPagePager(func(ctx context.Context, page, perPage int) ([]Movie, int, error) {
+		p := params
+		p.Page, p.PerPage = Int(page), Int(perPage)
+		items, err := m.Search(ctx, p)
+		return items, 0, err
+	}, params.Page, params.PerPage)
+}
+
+// Random returns a list of random movies.
+func (m *MovieService) Random(ctx context.Context, params MoviesRandomParams) ([]Movie, error) {
+	var res moviesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/random", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Movies, nil
+}
+
+// Discover returns a list of movies to discover.
+func (m *MovieService) Discover(ctx context.Context, params MoviesDiscoverParams) ([]Movie, error) {
+	var res moviesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/discover", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Movies, nil
+}
+
+// Favorites returns a list of favorite movies for the authenticated member or ID member. (ID member has priority over token)
+func (m *MovieService) Favorites(ctx context.Context, params MoviesFavoritesParams) ([]Movie, error) {
+	var res moviesResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/favorites", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Movies, nil
+}
+
+// AddFavorite add a movie to the member's favorite list.
+// Require a valid token.
+func (m *MovieService) AddFavorite(ctx context.Context, params MoviesAddFavoriteParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/movies/favorite", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// DeleteFavorite delete a movie from the member's favorite.
+// Require a valid token.
+func (m *MovieService) DeleteFavorite(ctx context.Context, params MoviesDeleteFavoriteParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodDelete, "/movies/favorite", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// Member returns a list of movies which belongs to the authenticated member or ID member. (ID member has priority over token)
+func (m *MovieService) Member(ctx context.Context, params MoviesMemberParams) (*MoviesMemberResponse, error) {
+	var res MoviesMemberResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/member", params, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// MemberPager returns a pager over the movies of the member, see Member.
+func (m *MovieService) MemberPager(params MoviesMemberParams) *Pager[Movie] {
+	return newPager(func(ctx context.Context, offset, limit int) ([]Movie, int, error) {
+		p := params
+		p.Start, p.Limit = Int(offset), Int(limit)
+		res, err := m.Member(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Movies, res.Total, nil
+	}, params.Start, params.Limit)
+}
+
+// Add add a movie to the member's account or update its state.
+// Require a valid token.
+func (m *MovieService) Add(ctx context.Context, params MoviesAddParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/movies/movie", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// Delete remove a movie from the member's account.
+// Require a valid token.
+func (m *MovieService) Delete(ctx context.Context, params MoviesDeleteParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodDelete, "/movies/movie", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// AddNote rate a movie.
+// Require a valid token.
+func (m *MovieService) AddNote(ctx context.Context, params MoviesAddNoteParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodPost, "/movies/note", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// DeleteNote delete a movie rating.
+// Require a valid token.
+func (m *MovieService) DeleteNote(ctx context.Context, params MoviesDeleteNoteParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodDelete, "/movies/note", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
+
+// Genres returns the list of available movies genres.
+func (m *MovieService) Genres(ctx context.Context, params MoviesGenresParams) (Genres, error) {
+	var res genresResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/genres", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Genres, nil
+}
+
+// Characters returns a list of characters for the movie.
+func (m *MovieService) Characters(ctx context.Context, params MoviesCharactersParams) ([]CharacterMovie, error) {
+	var res charactersMovieResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/characters", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Characters, nil
+}
+
+// Similars returns a list of similar movies.
+func (m *MovieService) Similars(ctx context.Context, params MoviesSimilarsParams) ([]SimilarMovie, error) {
+	var res similarsMovieResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/similars", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Similars, nil
+}
+
+// Videos returns a list of videos for the movie.
+func (m *MovieService) Videos(ctx context.Context, params MoviesVideosParams) ([]VideoMovie, error) {
+	var res videosMovieResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/videos", params, &res); err != nil {
+		return nil, err
+	}
+	return res.Videos, nil
+}
+
+// Scraper returns the movie matching a file name.
+// Require a valid token.
+func (m *MovieService) Scraper(ctx context.Context, params MoviesScraperParams) (*Movie, error) {
+	var res movieResponse
+	if err := m.client.doRequest(ctx, http.MethodGet, "/movies/scraper", params, &res); err != nil {
+		return nil, err
+	}
+	return &res.Movie, nil
+}
@@ -0,0 +1,334 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMovieService_Display(t *testing.T) {
+	testCases := []struct {
+		url    string
+		params MoviesDisplayParams
+	}{
+		{
+			url: "movies/movie?id=7",
+			params: MoviesDisplayParams{
+				ID: Int(7),
+			},
+		},
+		{
+			url: "movies/movie?imdb_id=tt0816692",
+			params: MoviesDisplayParams{
+				ImdbID: String("tt0816692"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			data, err := os.ReadFile("data/movies/display.json")
+			assert.NoError(t, err)
+
+			ts, bc := setup(t, "GET", fmt.Sprintf("/%s", tc.url), string(data))
+			defer ts.Close()
+
+			movie, err := bc.Movies.Display(context.Background(), tc.params)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 7, movie.ID)
+			assert.Equal(t, "Interstellar", movie.Title)
+			assert.Equal(t, "Christopher Nolan", movie.Director)
+			assert.Equal(t, 2014, movie.ProductionYear)
+			assert.Equal(t, Genres{"Aventure", "Drame", "Science-Fiction"}, movie.Genres)
+			assert.Equal(t, 5, *movie.Note.User)
+			assert.Equal(t, MovieStateSeen, movie.User.Status)
+			assert.Equal(t, true, movie.User.Favorited)
+		})
+	}
+}
+
+func TestMovieService_DisplayNotFound(t *testing.T) {
+	data, err := os.ReadFile("data/movies/not_found.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/movie?id=1"), string(data))
+	defer ts.Close()
+
+	_, err = bc.Movies.Display(context.Background(), MoviesDisplayParams{
+		ID: Int(1),
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, err.Error(), "Code: 4001, Message: Movie not found.\n")
+}
+
+func TestMovieService_List(t *testing.T) {
+	data, err := os.ReadFile("data/movies/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/list?limit=2&order=popularity"), string(data))
+	defer ts.Close()
+
+	movies, err := bc.Movies.List(context.Background(), MoviesListParams{
+		Order: Order(OrderPopularity),
+		Limit: Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(movies))
+	assert.Equal(t, "Interstellar", movies[0].Title)
+	assert.Equal(t, Genres{}, movies[0].Genres)
+	assert.Equal(t, "Inception", movies[1].Title)
+}
+
+func TestMovieService_Search(t *testing.T) {
+	data, err := os.ReadFile("data/movies/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/search?nbpp=2&title=in"), string(data))
+	defer ts.Close()
+
+	movies, err := bc.Movies.Search(context.Background(), MoviesSearchParams{
+		Title:   String("in"),
+		PerPage: Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(movies))
+}
+
+func TestMovieService_Random(t *testing.T) {
+	data, err := os.ReadFile("data/movies/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/random?nb=2"), string(data))
+	defer ts.Close()
+
+	movies, err := bc.Movies.Random(context.Background(), MoviesRandomParams{
+		Number: Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(movies))
+}
+
+func TestMovieService_Discover(t *testing.T) {
+	data, err := os.ReadFile("data/movies/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/discover?limit=2&type=popular"), string(data))
+	defer ts.Close()
+
+	movies, err := bc.Movies.Discover(context.Background(), MoviesDiscoverParams{
+		Type:  DiscoverMovie(DiscoverMoviePopular),
+		Limit: Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(movies))
+}
+
+func TestMovieService_Favorites(t *testing.T) {
+	data, err := os.ReadFile("data/movies/list.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/favorites?id=1"), string(data))
+	defer ts.Close()
+
+	movies, err := bc.Movies.Favorites(context.Background(), MoviesFavoritesParams{
+		ID: Int(1),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(movies))
+}
+
+func TestMovieService_AddFavorite(t *testing.T) {
+	data := `{"movie": {"user":{"favorited":true}}}`
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "movies/favorite?id=7"), data)
+	defer ts.Close()
+
+	movie, err := bc.Movies.AddFavorite(context.Background(), MoviesAddFavoriteParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, movie.User.Favorited)
+}
+
+func TestMovieService_DeleteFavorite(t *testing.T) {
+	data := `{"movie": {"user":{"favorited":false}}}`
+
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "movies/favorite?id=7"), data)
+	defer ts.Close()
+
+	movie, err := bc.Movies.DeleteFavorite(context.Background(), MoviesDeleteFavoriteParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, false, movie.User.Favorited)
+}
+
+func TestMovieService_Member(t *testing.T) {
+	data, err := os.ReadFile("data/movies/member.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/member?id=1&limit=2&state=1"), string(data))
+	defer ts.Close()
+
+	movies, err := bc.Movies.Member(context.Background(), MoviesMemberParams{
+		ID:    Int(1),
+		State: MovieState(MovieStateSeen),
+		Limit: Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(movies.Movies))
+	assert.Equal(t, 42, movies.Total)
+	assert.Equal(t, Tags{"space", "nolan"}, movies.Movies[0].User.Tags)
+}
+
+func TestMovieService_Add(t *testing.T) {
+	data := `{"movie": {"id": 7, "user":{"in_account":true,"status":0}}}`
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "movies/movie?id=7&state=0"), data)
+	defer ts.Close()
+
+	movie, err := bc.Movies.Add(context.Background(), MoviesAddParams{
+		ID:    7,
+		State: MovieState(MovieStateToSee),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, true, movie.User.InAccount)
+	assert.Equal(t, MovieStateToSee, movie.User.Status)
+}
+
+func TestMovieService_Delete(t *testing.T) {
+	data := `{"movie": {"id": 7, "user":{"in_account":false}}}`
+
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "movies/movie?id=7"), data)
+	defer ts.Close()
+
+	movie, err := bc.Movies.Delete(context.Background(), MoviesDeleteParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, false, movie.User.InAccount)
+}
+
+func TestMovieService_Note(t *testing.T) {
+	data := `{"movie": {"id": 7, "notes": {"total": 30413, "mean": 4.5322, "user": 4}}}`
+
+	ts, bc := setup(t, "POST", fmt.Sprintf("/%s", "movies/note?id=7&note=4"), data)
+	defer ts.Close()
+
+	movie, err := bc.Movies.AddNote(context.Background(), MoviesAddNoteParams{
+		ID:   7,
+		Note: 4,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, *movie.Note.User)
+}
+
+func TestMovieService_DeleteNote(t *testing.T) {
+	data := `{"movie": {"id": 7, "notes": {"total": 30412, "mean": 4.5321, "user": 0}}}`
+
+	ts, bc := setup(t, "DELETE", fmt.Sprintf("/%s", "movies/note?id=7"), data)
+	defer ts.Close()
+
+	movie, err := bc.Movies.DeleteNote(context.Background(), MoviesDeleteNoteParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, *movie.Note.User)
+}
+
+func TestMovieService_Genres(t *testing.T) {
+	data, err := os.ReadFile("data/movies/genres.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/genres"), string(data))
+	defer ts.Close()
+
+	genres, err := bc.Movies.Genres(context.Background(), MoviesGenresParams{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 6, len(genres))
+}
+
+func TestMovieService_Characters(t *testing.T) {
+	data, err := os.ReadFile("data/movies/characters.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/characters?id=7"), string(data))
+	defer ts.Close()
+
+	characters, err := bc.Movies.Characters(context.Background(), MoviesCharactersParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(characters))
+	assert.Equal(t, "Cooper", characters[0].Name)
+	assert.Equal(t, "Matthew McConaughey", characters[0].Actor)
+}
+
+func TestMovieService_Similars(t *testing.T) {
+	data, err := os.ReadFile("data/movies/similars.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/similars?id=7"), string(data))
+	defer ts.Close()
+
+	similars, err := bc.Movies.Similars(context.Background(), MoviesSimilarsParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(similars))
+	assert.Equal(t, "Gravity", similars[0].Title)
+	assert.Nil(t, similars[0].Notes)
+	assert.Nil(t, similars[0].Movie)
+}
+
+func TestMovieService_Videos(t *testing.T) {
+	data, err := os.ReadFile("data/movies/videos.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/videos?id=7"), string(data))
+	defer ts.Close()
+
+	videos, err := bc.Movies.Videos(context.Background(), MoviesVideosParams{
+		ID: 7,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(videos))
+	assert.Equal(t, "youtube", videos[0].Host)
+	assert.Equal(t, "trailer", videos[0].Type)
+}
+
+func TestMovieService_Scraper(t *testing.T) {
+	data, err := os.ReadFile("data/movies/display.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "movies/scraper?file=Interstellar.2014.1080p.mkv"), string(data))
+	defer ts.Close()
+
+	movie, err := bc.Movies.Scraper(context.Background(), MoviesScraperParams{
+		File: "Interstellar.2014.1080p.mkv",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 7, movie.ID)
+}
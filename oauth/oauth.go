@@ -0,0 +1,197 @@
+// Package oauth implements the OAuth 2.0 authorization code flow of the Betaseries API.
+//
+// The access token obtained at the end of the flow can be set on a gotaseries.Client:
+//
+//	conf := &oauth.Config{
+//		ClientID:     "YOUR_API_KEY",
+//		ClientSecret: "YOUR_SECRET",
+//		RedirectURL:  "https://example.com/callback",
+//	}
+//
+//	// Redirect the member to conf.AuthCodeURL(state), then in the callback:
+//	token, err := conf.Exchange(ctx, r.URL.Query().Get("code"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client.Token = token.AccessToken
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/florentsorel/gotaseries"
+)
+
+const version = "3.0"
+
+// Endpoint contains the Betaseries authorization and token URLs.
+type Endpoint struct {
+	AuthURL  string
+	TokenURL string
+}
+
+// BetaseriesEndpoint is the Betaseries production endpoint.
+var BetaseriesEndpoint = Endpoint{
+	AuthURL:  "https://www.betaseries.com/authorize",
+	TokenURL: "https://api.betaseries.com/members/access_token",
+}
+
+// Config describes a Betaseries application. ClientID is the API key of the application.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+
+	// Endpoint defaults to BetaseriesEndpoint when empty.
+	Endpoint Endpoint
+
+	// HTTPClient defaults to a client with a 30 seconds timeout when nil.
+	HTTPClient *http.Client
+}
+
+// Token is an access token returned by Betaseries.
+type Token struct {
+	AccessToken string `json:"access_token"`
+}
+
+type tokenResponse struct {
+	AccessToken string            `json:"access_token"`
+	Token       string            `json:"token"`
+	Errors      gotaseries.Errors `json:"errors"`
+}
+
+// AuthCodeURL returns the URL of the Betaseries consent page. The state is sent back
+// to the redirect URL and must be checked to protect against CSRF.
+func (c *Config) AuthCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("client_id", c.ClientID)
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	authURL := c.endpoint().AuthURL
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + v.Encode()
+	}
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange converts an authorization code into an access token. It returns
+// gotaseries.APIErrors when Betaseries rejects the code, and a
+// *gotaseries.HTTPError holding the beginning of the body when the server does
+// not answer with a Betaseries response, e.g. a 5xx status or an HTML page.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	if code == "" {
+		return nil, errors.New("oauth: code cannot be empty")
+	}
+
+	v := url.Values{}
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+	v.Set("redirect_uri", c.RedirectURL)
+	v.Set("code", code)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint().TokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-BetaSeries-Version", version)
+	req.Header.Set("X-BetaSeries-Key", c.ClientID)
+
+	res, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, gotaseries.MaxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// A Betaseries error is returned as is, any other response with an error
+	// status or which is not JSON as a *gotaseries.HTTPError.
+	var tr tokenResponse
+	if err = json.Unmarshal(body, &tr); err != nil {
+		return nil, gotaseries.NewHTTPError(res, body, err)
+	}
+
+	if err = tr.Errors.Err(); err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return nil, gotaseries.NewHTTPError(res, body, nil)
+	}
+
+	token := &Token{AccessToken: tr.AccessToken}
+	if token.AccessToken == "" {
+		token.AccessToken = tr.Token
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("oauth: server response is missing access_token")
+	}
+
+	return token, nil
+}
+
+// CallbackFunc receives the result of the code exchange done by Handler.
+type CallbackFunc func(w http.ResponseWriter, r *http.Request, token *Token, err error)
+
+// Handler returns an http.Handler to mount on the redirect URL. It checks the state
+// with verifyState, exchanges the code and calls fn with the token or the error.
+// Handler panics if verifyState is nil: the state protects the flow against CSRF
+// and cannot be left unchecked.
+func (c *Config) Handler(verifyState func(r *http.Request, state string) bool, fn CallbackFunc) http.Handler {
+	if verifyState == nil {
+		panic("oauth: nil verifyState")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		if e := q.Get("error"); e != "" {
+			fn(w, r, nil, fmt.Errorf("oauth: authorization denied: %s", e))
+			return
+		}
+
+		if !verifyState(r, q.Get("state")) {
+			fn(w, r, nil, errors.New("oauth: invalid state"))
+			return
+		}
+
+		token, err := c.Exchange(r.Context(), q.Get("code"))
+		fn(w, r, token, err)
+	})
+}
+
+func (c *Config) endpoint() Endpoint {
+	e := c.Endpoint
+	if e.AuthURL == "" {
+		e.AuthURL = BetaseriesEndpoint.AuthURL
+	}
+	if e.TokenURL == "" {
+		e.TokenURL = BetaseriesEndpoint.TokenURL
+	}
+	return e
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return &http.Client{Timeout: time.Second * 30}
+}
@@ -0,0 +1,153 @@
+package oauth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/florentsorel/gotaseries"
+	"github.com/florentsorel/gotaseries/oauth"
+	"github.com/florentsorel/gotaseries/oauth/oauthtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	conf := &oauth.Config{
+		ClientID:    "client_id",
+		RedirectURL: "https://example.com/callback",
+	}
+
+	assert.Equal(t, "https://www.betaseries.com/authorize?client_id=client_id&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&state=xyz", conf.AuthCodeURL("xyz"))
+}
+
+func TestConfig_Flow(t *testing.T) {
+	bs := oauthtest.NewServer("client_id", "secret")
+	defer bs.Close()
+
+	var token *oauth.Token
+	var callbackErr error
+
+	app := httptest.NewServer(nil)
+	defer app.Close()
+
+	conf := &oauth.Config{
+		ClientID:     "client_id",
+		ClientSecret: "secret",
+		RedirectURL:  app.URL + "/callback",
+		Endpoint:     bs.Endpoint(),
+	}
+
+	app.Config.Handler = conf.Handler(func(r *http.Request, state string) bool {
+		return state == "xyz"
+	}, func(w http.ResponseWriter, r *http.Request, tok *oauth.Token, err error) {
+		token, callbackErr = tok, err
+	})
+
+	res, err := http.Get(conf.AuthCodeURL("xyz"))
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	assert.NoError(t, callbackErr)
+	assert.NotNil(t, token)
+	assert.True(t, bs.Valid(token.AccessToken))
+}
+
+func TestConfig_HandlerInvalidState(t *testing.T) {
+	bs := oauthtest.NewServer("client_id", "secret")
+	defer bs.Close()
+
+	conf := &oauth.Config{
+		ClientID:     "client_id",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		Endpoint:     bs.Endpoint(),
+	}
+
+	var callbackErr error
+	h := conf.Handler(func(r *http.Request, state string) bool {
+		return state == "xyz"
+	}, func(w http.ResponseWriter, r *http.Request, tok *oauth.Token, err error) {
+		callbackErr = err
+	})
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/callback?code=abc&state=other", nil))
+
+	assert.EqualError(t, callbackErr, "oauth: invalid state")
+}
+
+func TestConfig_HandlerNilState(t *testing.T) {
+	conf := &oauth.Config{ClientID: "client_id"}
+
+	assert.PanicsWithValue(t, "oauth: nil verifyState", func() {
+		conf.Handler(nil, func(w http.ResponseWriter, r *http.Request, tok *oauth.Token, err error) {})
+	})
+}
+
+func TestConfig_ExchangeInvalidCode(t *testing.T) {
+	bs := oauthtest.NewServer("client_id", "secret")
+	defer bs.Close()
+
+	conf := &oauth.Config{
+		ClientID:     "client_id",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		Endpoint:     bs.Endpoint(),
+	}
+
+	_, err := conf.Exchange(context.Background(), "unknown")
+	assert.Error(t, err)
+
+	assert.Equal(t, err.Error(), "Code: 4005, Message: Invalid code.\n")
+}
+
+func TestConfig_ExchangeInvalidSecret(t *testing.T) {
+	bs := oauthtest.NewServer("client_id", "secret")
+	defer bs.Close()
+
+	conf := &oauth.Config{
+		ClientID:     "client_id",
+		ClientSecret: "wrong",
+		Endpoint:     bs.Endpoint(),
+	}
+
+	_, err := conf.Exchange(context.Background(), "code")
+	assert.Error(t, err)
+
+	assert.Equal(t, err.Error(), "Code: 1001, Message: Invalid client credentials.\n")
+}
+
+func TestConfig_ExchangeHTTPError(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "html error page", status: http.StatusBadGateway, body: "<html>Bad Gateway</html>"},
+		{name: "error status", status: http.StatusBadRequest, body: `{"access_token": "token", "errors": []}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer ts.Close()
+
+			conf := &oauth.Config{
+				ClientID: "client_id",
+				Endpoint: oauth.Endpoint{AuthURL: ts.URL, TokenURL: ts.URL},
+			}
+
+			token, err := conf.Exchange(context.Background(), "code")
+			assert.Nil(t, token)
+
+			var httpErr *gotaseries.HTTPError
+			assert.True(t, errors.As(err, &httpErr))
+			assert.Equal(t, tc.status, httpErr.StatusCode)
+			assert.Equal(t, tc.body, string(httpErr.Body))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Package oauthtest provides a local server standing in for Betaseries during
+// the OAuth 2.0 authorization code flow.
+package oauthtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/florentsorel/gotaseries/oauth"
+)
+
+// Server is a fake Betaseries authorization server. The authorize endpoint grants
+// access immediately and redirects to the redirect URI with a one-time code.
+type Server struct {
+	*httptest.Server
+
+	ClientID     string
+	ClientSecret string
+
+	mu     sync.Mutex
+	codes  map[string]string
+	tokens map[string]bool
+}
+
+// NewServer starts a Server accepting the given client credentials. The caller must call Close.
+func NewServer(clientID, clientSecret string) *Server {
+	s := &Server{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		codes:        make(map[string]string),
+		tokens:       make(map[string]bool),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", s.authorize)
+	mux.HandleFunc("/members/access_token", s.accessToken)
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Endpoint returns the endpoint to set on an oauth.Config.
+func (s *Server) Endpoint() oauth.Endpoint {
+	return oauth.Endpoint{
+		AuthURL:  s.URL + "/authorize",
+		TokenURL: s.URL + "/members/access_token",
+	}
+}
+
+// Valid reports whether the access token has been issued by the server.
+func (s *Server) Valid(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[token]
+}
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != s.ClientID {
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	}
+
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirectURI.String() == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code := random()
+	s.mu.Lock()
+	s.codes[code] = redirectURI.String()
+	s.mu.Unlock()
+
+	v := redirectURI.Query()
+	v.Set("code", code)
+	if state := q.Get("state"); state != "" {
+		v.Set("state", state)
+	}
+	redirectURI.RawQuery = v.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (s *Server) accessToken(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, 0, "Method not allowed.")
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+
+	if r.PostForm.Get("client_id") != s.ClientID || r.PostForm.Get("client_secret") != s.ClientSecret {
+		writeError(w, http.StatusBadRequest, 1001, "Invalid client credentials.")
+		return
+	}
+
+	code := r.PostForm.Get("code")
+
+	s.mu.Lock()
+	redirectURI, ok := s.codes[code]
+	delete(s.codes, code)
+	s.mu.Unlock()
+
+	if !ok || redirectURI != r.PostForm.Get("redirect_uri") {
+		writeError(w, http.StatusBadRequest, 4005, "Invalid code.")
+		return
+	}
+
+	token := random()
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": token,
+		"errors":       []any{},
+	})
+}
+
+func writeError(w http.ResponseWriter, status, code int, text string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{"code": code, "text": text}},
+	})
+}
+
+func random() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,155 @@
+package gotaseries
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*Client) error
+
+// Logger is the interface used by the client to report retried requests.
+// It is implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+// The default client has a timeout of 30 seconds.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("http client cannot be nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the transport of the HTTP client used to send the
+// requests. The client given to WithHTTPClient is copied, not modified.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) error {
+		if transport == nil {
+			return errors.New("transport cannot be nil")
+		}
+		httpClient := *c.httpClient
+		httpClient.Transport = transport
+		c.httpClient = &httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the URL of the Betaseries API, https://api.betaseries.com/api by default.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base url: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid base url %q: scheme and host are required", baseURL)
+		}
+		c.baseURL = *u
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithToken sets the token of the member authenticated on the requests.
+func WithToken(token string) Option {
+	return func(c *Client) error {
+		c.Token = token
+		return nil
+	}
+}
+
+// WithLocale sets the locale used by default on the requests.
+func WithLocale(locale LocaleType) Option {
+	return func(c *Client) error {
+		c.Locale = locale
+		return nil
+	}
+}
+
+// WithRetry sets the policy used to retry requests failing with a transient error.
+func WithRetry(policy *RetryPolicy) Option {
+	return func(c *Client) error {
+		c.Retry = policy
+		return nil
+	}
+}
+
+// WithRateLimiter sets the limiter of the requests sent by the client.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) error {
+		c.Limiter = limiter
+		return nil
+	}
+}
+
+// WithLogger sets the logger reporting retried requests.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithMiddleware adds middlewares hooking into the requests sent by the client.
+// BeforeSend hooks run in the order the middlewares are added, AfterReceive
+// hooks in the reverse order.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithTracer sets the tracer starting a span for each service call.
+func WithTracer(tracer Tracer) Option {
+	return func(c *Client) error {
+		c.tracer = tracer
+		return nil
+	}
+}
+
+// WithMeter sets the meter recording the metrics of the service calls.
+func WithMeter(meter Meter) Option {
+	return func(c *Client) error {
+		c.meter = meter
+		return nil
+	}
+}
+
+// WithCache sets the cache of the responses of the GET requests. The responses
+// are cached for the duration set for their endpoint, DefaultCacheTTLs when
+// ttls is nil.
+//
+// A fresh cached response is returned without sending a request, so neither the
+// middlewares nor the tracer and the meter see it. CaptureResponse reports it
+// with Response.Cached set.
+//
+// The cached responses about a show are invalidated by the requests of the
+// client modifying the show or one of its episodes. The other changes, e.g.
+// made by another client or on the account of the member, only show once the
+// responses expire.
+func WithCache(cache Cache, ttls CacheTTLs) Option {
+	return func(c *Client) error {
+		if ttls == nil {
+			ttls = DefaultCacheTTLs()
+		}
+		c.cache = cache
+		c.cacheTTLs = ttls
+		return nil
+	}
+}
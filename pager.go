@@ -0,0 +1,201 @@
+package gotaseries
+
+import "context"
+
+// defaultPageSize is the number of items per page requested by a pager when
+// the params do not set it.
+const defaultPageSize = 20
+
+// pageFunc fetches the limit items following the offset first ones, and the
+// total number of items when the endpoint reports it, 0 otherwise.
+type pageFunc[T any] func(ctx context.Context, offset, limit int) ([]T, int, error)
+
+type pageResult[T any] struct {
+	items []T
+	total int
+	meta  Response
+	err   error
+}
+
+// Pager iterates over the results of a paginated endpoint, whatever it is
+// paginated by page and nbpp, limit and offset, or start and limit. It stops on
+// a page shorter than the page size or once the total reported by the
+// endpoint is reached.
+//
+// A Pager is not safe for concurrent use.
+//
+// Example:
+//
+//	pager := client.Shows.SearchPager(gotaseries.ShowsSearchParams{Title: gotaseries.String("dexter")})
+//	for pager.Next(ctx) {
+//		fmt.Println(pager.Item().Title)
+//	}
+//	if err := pager.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type Pager[T any] struct {
+	fetch    pageFunc[T]
+	offset   int
+	limit    int
+	done     bool
+	prefetch bool
+	pending  chan pageResult[T]
+
+	items []T
+	index int
+	err   error
+}
+
+func newPager[T any](fetch pageFunc[T], offset, limit *int) *Pager[T] {
+	p := &Pager[T]{
+		fetch: fetch,
+		limit: defaultPageSize,
+	}
+
+	if limit != nil && *limit > 0 {
+		p.limit = *limit
+	}
+
+	if offset != nil && *offset > 0 {
+		p.offset = *offset
+	}
+
+	return p
+}
+
+// newPagePager returns a pager of an endpoint paginated by page, starting at 1,
+// and number of items per page.
+func newPagePager[T any](fetch func(ctx context.Context, page, perPage int) ([]T, int, error), page, perPage *int) *Pager[T] {
+	p := newPager[T](nil, nil, perPage)
+	if page != nil && *page > 1 {
+		p.offset = (*page - 1) * p.limit
+	}
+
+	p.fetch = func(ctx context.Context, offset, limit int) ([]T, int, error) {
+		return fetch(ctx, offset/limit+1, limit)
+	}
+
+	return p
+}
+
+// Prefetch makes the pager fetch the next page in the background while the
+// current one is processed. The prefetch is canceled with the context given to
+// the call which started it, but the Response captured by that context, see
+// CaptureResponse, is only filled by the call returning the page.
+func (p *Pager[T]) Prefetch() *Pager[T] {
+	p.prefetch = true
+	return p
+}
+
+// Done reports whether all the pages have been fetched.
+func (p *Pager[T]) Done() bool {
+	return p.done
+}
+
+// NextPage returns the items of the next page, or nil once all the pages have
+// been fetched. The page is fetched again by the next call after an error.
+func (p *Pager[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if p.pending == nil {
+		p.start(ctx)
+	}
+
+	var res pageResult[T]
+	select {
+	case res = <-p.pending:
+		p.pending = nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if meta := responseFromContext(ctx); meta != nil {
+		*meta = res.meta
+	}
+
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	p.offset += len(res.items)
+	if len(res.items) < p.limit || (res.total > 0 && p.offset >= res.total) {
+		p.done = true
+	}
+
+	if !p.done && p.prefetch {
+		p.start(ctx)
+	}
+
+	return res.items, nil
+}
+
+func (p *Pager[T]) start(ctx context.Context) {
+	pending := make(chan pageResult[T], 1)
+	offset, limit := p.offset, p.limit
+
+	// The page is fetched with its own Response, the one captured by ctx may be
+	// read or reused by the caller while the page is prefetched.
+	go func() {
+		var meta Response
+		items, total, err := p.fetch(CaptureResponse(ctx, &meta), offset, limit)
+		pending <- pageResult[T]{items: items, total: total, meta: meta, err: err}
+	}()
+
+	p.pending = pending
+}
+
+// Next advances to the next item, fetching the next page when needed. It
+// returns false once all the items have been read or on error, see Err.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.index++
+	for p.index >= len(p.items) {
+		if p.done {
+			return false
+		}
+
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.items = items
+		p.index = 0
+	}
+
+	return true
+}
+
+// Item returns the current item, after a call to Next returning true.
+func (p *Pager[T]) Item() T {
+	return p.items[p.index]
+}
+
+// Err returns the error which stopped Next.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All returns the items of all the remaining pages.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for !p.done {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, items...)
+	}
+
+	return all, nil
+}
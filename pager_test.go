@@ -0,0 +1,228 @@
+package gotaseries
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupPages returns a server listing count shows, paginated by page and nbpp,
+// limit and offset or start and limit according to the query string.
+func setupPages(t *testing.T, count int, total bool) (*httptest.Server, *Client, *int32) {
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		q := r.URL.Query()
+		atoi := func(key string) int {
+			v, _ := strconv.Atoi(q.Get(key))
+			return v
+		}
+
+		var offset, limit int
+		switch {
+		case q.Has("page"):
+			limit = atoi("nbpp")
+			offset = (atoi("page") - 1) * limit
+		case q.Has("offset"):
+			offset, limit = atoi("offset"), atoi("limit")
+		default:
+			offset, limit = atoi("start"), atoi("limit")
+		}
+
+		res := struct {
+			Shows  []Show `json:"shows"`
+			Total  int    `json:"total,omitempty"`
+			Errors Errors `json:"errors"`
+		}{Shows: []Show{}, Errors: Errors{}}
+
+		for i := offset; i < offset+limit && i < count; i++ {
+			res.Shows = append(res.Shows, Show{ID: i + 1})
+		}
+
+		if total {
+			res.Total = count
+		}
+
+		assert.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	return ts, bc, &requests
+}
+
+func ids(shows []Show) []int {
+	var ids []int
+	for _, s := range shows {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func TestPager_Page(t *testing.T) {
+	ts, bc, requests := setupPages(t, 25, false)
+	defer ts.Close()
+
+	pager := bc.Shows.SearchPager(ShowsSearchParams{PerPage: Int(10)})
+
+	var got []int
+	for pager.Next(context.Background()) {
+		got = append(got, pager.Item().ID)
+	}
+	assert.NoError(t, pager.Err())
+
+	assert.Len(t, got, 25)
+	assert.Equal(t, 1, got[0])
+	assert.Equal(t, 25, got[24])
+	assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+	assert.True(t, pager.Done())
+}
+
+func TestPager_StartPage(t *testing.T) {
+	ts, bc, _ := setupPages(t, 25, false)
+	defer ts.Close()
+
+	shows, err := bc.Shows.UnratedPager(ShowsUnratedParams{PerPage: Int(10), Page: Int(2)}).All(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}, ids(shows))
+}
+
+func TestPager_Total(t *testing.T) {
+	ts, bc, requests := setupPages(t, 20, true)
+	defer ts.Close()
+
+	// The total stops the pager on a full last page, without an empty request.
+	shows, err := bc.Shows.MemberPager(ShowsMemberParams{Limit: Int(10)}).All(context.Background())
+	assert.NoError(t, err)
+
+	assert.Len(t, shows, 20)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestPager_ExactPages(t *testing.T) {
+	ts, bc, requests := setupPages(t, 20, false)
+	defer ts.Close()
+
+	// Without total, an empty page ends the pagination.
+	pager := bc.Shows.FavoritesPager(ShowsFavoritesParams{Limit: Int(10), Offset: Int(5)})
+
+	page, err := pager.NextPage(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, ids(page))
+
+	page, err = pager.NextPage(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, page, 5)
+	assert.True(t, pager.Done())
+
+	page, err = pager.NextPage(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, page)
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestPager_DefaultPageSize(t *testing.T) {
+	ts, bc, requests := setupPages(t, 45, false)
+	defer ts.Close()
+
+	shows, err := bc.Shows.SearchPager(ShowsSearchParams{}).All(context.Background())
+	assert.NoError(t, err)
+
+	assert.Len(t, shows, 45)
+	assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+}
+
+func TestPager_Prefetch(t *testing.T) {
+	ts, bc, requests := setupPages(t, 30, false)
+	defer ts.Close()
+
+	pager := bc.Shows.SearchPager(ShowsSearchParams{PerPage: Int(10)}).Prefetch()
+
+	_, err := pager.NextPage(context.Background())
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(requests) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	shows, err := pager.All(context.Background())
+	assert.NoError(t, err)
+
+	assert.Len(t, shows, 20)
+	assert.Equal(t, int32(4), atomic.LoadInt32(requests))
+}
+
+func TestPager_ContextCanceled(t *testing.T) {
+	ts, bc, requests := setupPages(t, 30, false)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	pager := bc.Shows.SearchPager(ShowsSearchParams{PerPage: Int(10)})
+
+	assert.True(t, pager.Next(ctx))
+	cancel()
+
+	for pager.Next(ctx) {
+	}
+
+	assert.True(t, errors.Is(pager.Err(), context.Canceled))
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestPager_Error(t *testing.T) {
+	var requests int32
+
+	pager := newPager(func(ctx context.Context, offset, limit int) ([]int, int, error) {
+		if atomic.AddInt32(&requests, 1) == 2 {
+			return nil, 0, errors.New("temporary error")
+		}
+		return []int{offset}, 3, nil
+	}, nil, Int(1))
+
+	page, err := pager.NextPage(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, page)
+
+	_, err = pager.NextPage(context.Background())
+	assert.EqualError(t, err, "temporary error")
+
+	// The failed page is fetched again.
+	rest, err := pager.All(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, rest)
+}
+
+func TestPager_PrefetchCaptureResponse(t *testing.T) {
+	pager := newPager(func(ctx context.Context, offset, limit int) ([]int, int, error) {
+		responseFromContext(ctx).Attempts = offset + 1
+		return []int{offset}, 3, nil
+	}, nil, Int(1)).Prefetch()
+
+	var res Response
+	ctx := CaptureResponse(context.Background(), &res)
+
+	for i := 0; i < 3; i++ {
+		page, err := pager.NextPage(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{i}, page)
+		assert.Equal(t, i+1, res.Attempts)
+
+		// The prefetch of the next page does not write into res.
+		res.Attempts = -1
+		time.Sleep(5 * time.Millisecond)
+		assert.Equal(t, -1, res.Attempts)
+	}
+}
@@ -0,0 +1,141 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	PlanningTypeCategory  PlanningType = "category"
+	PlanningTypePremieres PlanningType = "premieres"
+)
+
+type PlanningService Service
+
+type PlanningType string
+
+type calendarResponse struct {
+	Days   []PlanningDay `json:"days"`
+	Errors Errors        `json:"errors"`
+}
+
+// PlanningDay holds the episodes aired on a given day.
+type PlanningDay struct {
+	Date     Date      `json:"date"`
+	Episodes []Episode `json:"events"`
+}
+
+type PlanningGeneralParams struct {
+	Date   *Date         `url:"date"`
+	Before *int          `url:"before"`
+	After  *int          `url:"after"`
+	Type   *PlanningType `url:"type"`
+	Locale *LocaleType   `url:"locale"`
+}
+
+type PlanningIncomingParams struct {
+	Locale *LocaleType `url:"locale"`
+}
+
+type PlanningMemberParams struct {
+	ID     *int        `url:"id"`
+	Unseen *bool       `url:"unseen"`
+	Month  *string     `url:"month"`
+	Locale *LocaleType `url:"locale"`
+}
+
+type PlanningCalendarParams struct {
+	Start  *time.Time  `url:"start"`
+	End    *time.Time  `url:"end"`
+	Locale *LocaleType `url:"locale"`
+}
+
+// General returns the episodes aired around a date, grouped by day.
+func (p *PlanningService) General(ctx context.Context, params PlanningGeneralParams) ([]PlanningDay, error) {
+	var res episodesResponse
+	if err := p.client.doRequest(ctx, http.MethodGet, "/planning/general", params, &res); err != nil {
+		return nil, err
+	}
+	return GroupByDate(res.Episodes), nil
+}
+
+// Incoming returns the first episode of the upcoming series, grouped by day.
+func (p *PlanningService) Incoming(ctx context.Context, params PlanningIncomingParams) ([]PlanningDay, error) {
+	var res episodesResponse
+	if err := p.client.doRequest(ctx, http.MethodGet, "/planning/incoming", params, &res); err != nil {
+		return nil, err
+	}
+	return GroupByDate(res.Episodes), nil
+}
+
+// Member returns the planning of the authenticated member or ID member, grouped by day. (ID member has priority over token)
+func (p *PlanningService) Member(ctx context.Context, params PlanningMemberParams) ([]PlanningDay, error) {
+	var res episodesResponse
+	if err := p.client.doRequest(ctx, http.MethodGet, "/planning/member", params, &res); err != nil {
+		return nil, err
+	}
+	return GroupByDate(res.Episodes), nil
+}
+
+// Calendar returns the episodes of the authenticated member's series aired between two dates, grouped by day.
+// Require a valid token.
+//
+// Example:
+//
+//	start := time.Now()
+//	days, err := client.Planning.Calendar(context.Background(), gotaseries.PlanningCalendarParams{
+//		Start: gotaseries.Time(start),
+//		End:   gotaseries.Time(start.AddDate(0, 0, 7)),
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, day := range days {
+//		fmt.Printf("%s: %d episodes\n", day.Date.String(), len(day.Episodes))
+//	}
+func (p *PlanningService) Calendar(ctx context.Context, params PlanningCalendarParams) ([]PlanningDay, error) {
+	var res calendarResponse
+	if err := p.client.doRequest(ctx, http.MethodGet, "/planning/calendar", params, &res); err != nil {
+		return nil, err
+	}
+	return GroupByDate(flatten(res.Days)), nil
+}
+
+// GroupByDate groups episodes by air date. Days are sorted chronologically and
+// episodes keep their original order within a day.
+func GroupByDate(episodes []Episode) []PlanningDay {
+	index := make(map[time.Time]int)
+	var days []PlanningDay
+
+	for _, e := range episodes {
+		key := time.Time(e.Date)
+		i, ok := index[key]
+		if !ok {
+			i = len(days)
+			index[key] = i
+			days = append(days, PlanningDay{Date: e.Date})
+		}
+		days[i].Episodes = append(days[i].Episodes, e)
+	}
+
+	sort.SliceStable(days, func(i, j int) bool {
+		return time.Time(days[i].Date).Before(time.Time(days[j].Date))
+	})
+
+	return days
+}
+
+func flatten(days []PlanningDay) []Episode {
+	var episodes []Episode
+	for _, d := range days {
+		for _, e := range d.Episodes {
+			if time.Time(e.Date).IsZero() {
+				e.Date = d.Date
+			}
+			episodes = append(episodes, e)
+		}
+	}
+	return episodes
+}
@@ -0,0 +1,95 @@
+package gotaseries
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanningService_General(t *testing.T) {
+	data, err := os.ReadFile("data/planning/general.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "planning/general?after=1&before=0&date=2024-04-03"), string(data))
+	defer ts.Close()
+
+	days, err := bc.Planning.General(context.Background(), PlanningGeneralParams{
+		Date:   ToDate(time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)),
+		Before: Int(0),
+		After:  Int(1),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(days))
+	assert.Equal(t, "2024-04-03", days[0].Date.String())
+	assert.Equal(t, 2, len(days[0].Episodes))
+	assert.Equal(t, "Hacks", days[0].Episodes[0].Show.Title)
+	assert.Equal(t, "Ripley", days[0].Episodes[1].Show.Title)
+	assert.Equal(t, "2024-04-04", days[1].Date.String())
+	assert.Equal(t, "Sugar", days[1].Episodes[0].Show.Title)
+}
+
+func TestPlanningService_Member(t *testing.T) {
+	data, err := os.ReadFile("data/planning/general.json")
+	assert.NoError(t, err)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "planning/member?id=1&month=2024-04&unseen=true"), string(data))
+	defer ts.Close()
+
+	days, err := bc.Planning.Member(context.Background(), PlanningMemberParams{
+		ID:     Int(1),
+		Unseen: Bool(true),
+		Month:  String("2024-04"),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(days))
+}
+
+func TestPlanningService_Calendar(t *testing.T) {
+	data, err := os.ReadFile("data/planning/calendar.json")
+	assert.NoError(t, err)
+
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+
+	ts, bc := setup(t, "GET", fmt.Sprintf("/%s", "planning/calendar?end=1712534400&start=1711929600"), string(data))
+	defer ts.Close()
+
+	days, err := bc.Planning.Calendar(context.Background(), PlanningCalendarParams{
+		Start: Time(start),
+		End:   Time(end),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(days))
+	assert.Equal(t, "2024-04-03", days[0].Date.String())
+	assert.Equal(t, 1, len(days[0].Episodes))
+	assert.Equal(t, "2024-04-05", days[1].Date.String())
+	assert.Equal(t, 2, len(days[1].Episodes))
+	assert.Equal(t, "S01E03", days[1].Episodes[1].Code)
+}
+
+func TestGroupByDate(t *testing.T) {
+	d1 := Date(time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC))
+	d2 := Date(time.Date(2024, 4, 4, 0, 0, 0, 0, time.UTC))
+
+	days := GroupByDate([]Episode{
+		{ID: 1, Date: d2},
+		{ID: 2, Date: d1},
+		{ID: 3, Date: d2},
+	})
+
+	assert.Equal(t, 2, len(days))
+	assert.Equal(t, d1, days[0].Date)
+	assert.Equal(t, 2, days[0].Episodes[0].ID)
+	assert.Equal(t, d2, days[1].Date)
+	assert.Equal(t, 1, days[1].Episodes[0].ID)
+	assert.Equal(t, 3, days[1].Episodes[1].ID)
+
+	assert.Nil(t, GroupByDate(nil))
+}
@@ -42,6 +42,12 @@ func Time(v time.Time) *time.Time {
 	return &v
 }
 
+// ToDate returns a pointer to the Date of the time.Time value passed in.
+func ToDate(v time.Time) *Date {
+	d := Date(v)
+	return &d
+}
+
 func OrderFavorite(v OrderFavoriteType) *OrderFavoriteType {
 	return &v
 }
@@ -57,3 +63,23 @@ func StatusFavorite(v StatusFavoriteType) *StatusFavoriteType {
 func StatusShowMember(v StatusShowMemberType) *StatusShowMemberType {
 	return &v
 }
+
+func MovieState(v MovieStateType) *MovieStateType {
+	return &v
+}
+
+func DiscoverMovie(v DiscoverMovieType) *DiscoverMovieType {
+	return &v
+}
+
+func Only(v OnlyType) *OnlyType {
+	return &v
+}
+
+func Planning(v PlanningType) *PlanningType {
+	return &v
+}
+
+func OrderComment(v OrderCommentType) *OrderCommentType {
+	return &v
+}
@@ -0,0 +1,224 @@
+package gotaseries
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryEncoder is implemented by parameter types encoding themselves in the
+// query string or the body of a request. key is the url tag of the field, or is
+// empty when the params struct passed to a service implements QueryEncoder,
+// in which case its fields are not walked by reflection.
+//
+// EncodeQuery may have a value or a pointer receiver, the params and their
+// fields being found by value or by pointer alike. The types not implementing
+// QueryEncoder keep being encoded by reflection, as described by encodeQuery.
+//
+// Example:
+//
+//	type Rating float64
+//
+//	func (r Rating) EncodeQuery(key string, values url.Values) error {
+//		values.Set(key, strconv.FormatFloat(float64(r), 'f', 1, 64))
+//		return nil
+//	}
+type QueryEncoder interface {
+	EncodeQuery(key string, values url.Values) error
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// encodeQuery adds the parameters of params to values.
+//
+// The fields having an url tag are encoded according to their kind: booleans,
+// integers, floats and strings, including the enums like LocaleType, are
+// formatted with strconv, time.Time as a Unix timestamp and other types
+// implementing fmt.Stringer with their String method. Slices are joined by
+// commas and skipped when empty, nil pointers are skipped. The fields of a
+// struct field are encoded as key[field], or as fields of the parent when it has
+// no url tag, like an embedded struct of an exported type. Fields of another
+// type are reported as an error.
+func encodeQuery(params any, values url.Values) error {
+	if params == nil {
+		return nil
+	}
+
+	if e, ok := params.(QueryEncoder); ok {
+		return e.EncodeQuery("", values)
+	}
+
+	v := reflect.ValueOf(params)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if e, ok := queryEncoder(v); ok {
+		return e.EncodeQuery("", values)
+	}
+
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported params type %s", v.Type())
+	}
+
+	return encodeStruct("", v, values)
+}
+
+func encodeStruct(prefix string, v reflect.Value, values url.Values) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if tag == "" {
+			if fv.Kind() == reflect.Struct && fv.Type() != timeType {
+				if err := encodeStruct(prefix, fv, values); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		key := tag
+		if prefix != "" {
+			key = prefix + "[" + tag + "]"
+		}
+
+		if err := encodeField(key, fv, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeField(key string, v reflect.Value, values url.Values) error {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if e, ok := queryEncoder(v); ok {
+		return e.EncodeQuery(key, values)
+	}
+
+	switch {
+	case v.Type() == timeType:
+		values.Set(key, strconv.FormatInt(v.Interface().(time.Time).Unix(), 10))
+		return nil
+	case v.Kind() == reflect.Struct && !isStringer(v):
+		return encodeStruct(key, v, values)
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if v.Len() == 0 {
+			return nil
+		}
+
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			if elem.Kind() == reflect.Ptr {
+				if elem.IsNil() {
+					continue
+				}
+				elem = elem.Elem()
+			}
+
+			s, err := formatValue(key, elem)
+			if err != nil {
+				return err
+			}
+			parts = append(parts, s)
+		}
+
+		values.Set(key, strings.Join(parts, ","))
+		return nil
+	}
+
+	s, err := formatValue(key, v)
+	if err != nil {
+		return err
+	}
+
+	values.Set(key, s)
+	return nil
+}
+
+func formatValue(key string, v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.String:
+		return v.String(), nil
+	}
+
+	if v.Type() == timeType {
+		return strconv.FormatInt(v.Interface().(time.Time).Unix(), 10), nil
+	}
+
+	if s, ok := stringer(v); ok {
+		return s.String(), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s for parameter %s", v.Type(), key)
+}
+
+func isStringer(v reflect.Value) bool {
+	_, ok := stringer(v)
+	return ok
+}
+
+// queryEncoder returns v as a QueryEncoder, also when EncodeQuery has a pointer
+// receiver.
+func queryEncoder(v reflect.Value) (QueryEncoder, bool) {
+	if e, ok := v.Interface().(QueryEncoder); ok {
+		return e, true
+	}
+
+	e, ok := addr(v).Interface().(QueryEncoder)
+	return e, ok
+}
+
+// stringer returns v as a fmt.Stringer, also when String has a pointer receiver.
+func stringer(v reflect.Value) (fmt.Stringer, bool) {
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s, true
+	}
+
+	s, ok := addr(v).Interface().(fmt.Stringer)
+	return s, ok
+}
+
+// addr returns a pointer to v, or to a copy of v when it is not addressable.
+func addr(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
+	}
+
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p
+}
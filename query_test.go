@@ -0,0 +1,240 @@
+package gotaseries
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type rating float64
+
+func (r rating) EncodeQuery(key string, values url.Values) error {
+	values.Set(key, strconv.FormatFloat(float64(r), 'f', 1, 64))
+	return nil
+}
+
+type episodeQuery struct {
+	ID int
+}
+
+func (q episodeQuery) EncodeQuery(key string, values url.Values) error {
+	values.Set("id", strconv.Itoa(q.ID))
+	values.Set("v", "2")
+	return nil
+}
+
+type seasonQuery struct {
+	Number int
+}
+
+func (q *seasonQuery) EncodeQuery(key string, values url.Values) error {
+	if key == "" {
+		key = "season"
+	}
+	values.Set(key, "S"+strconv.Itoa(q.Number))
+	return nil
+}
+
+type sortOrder int
+
+func (o sortOrder) String() string {
+	return "desc"
+}
+
+type PaginationParams struct {
+	Limit  *int `url:"limit"`
+	Offset *int `url:"offset"`
+}
+
+type filterParams struct {
+	Genre string `url:"genre"`
+	Year  uint16 `url:"year"`
+}
+
+type allParams struct {
+	PaginationParams
+	Bool     bool         `url:"bool"`
+	Int      int64        `url:"int"`
+	Uint     uint         `url:"uint"`
+	Float    float64      `url:"float"`
+	Float32  float32      `url:"float32"`
+	String   string       `url:"string"`
+	Order    OrderType    `url:"order"`
+	Locales  []LocaleType `url:"locales"`
+	IDs      []int        `url:"ids"`
+	Empty    []string     `url:"empty"`
+	Nil      *string      `url:"nil"`
+	Since    *time.Time   `url:"since"`
+	Date     Date         `url:"date"`
+	Rating   rating       `url:"rating"`
+	Filter   filterParams `url:"filter"`
+	Skipped  string       `url:"-"`
+	Untagged string
+	hidden   string
+}
+
+func TestEncodeQuery(t *testing.T) {
+	since := time.Unix(1712102400, 0)
+
+	params := allParams{
+		PaginationParams: PaginationParams{Limit: Int(20)},
+		Bool:             true,
+		Int:              -5,
+		Uint:             7,
+		Float:            8.25,
+		Float32:          0.5,
+		String:           "Game of Thrones",
+		Order:            OrderPopularity,
+		Locales:          []LocaleType{LocaleFR, LocaleEN},
+		IDs:              []int{1, 2, 3},
+		Empty:            []string{},
+		Since:            &since,
+		Date:             Date(time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)),
+		Rating:           4,
+		Filter:           filterParams{Genre: "Drama", Year: 2011},
+		Skipped:          "skipped",
+		Untagged:         "untagged",
+		hidden:           "hidden",
+	}
+
+	values := url.Values{}
+	assert.NoError(t, encodeQuery(params, values))
+
+	assert.Equal(t, url.Values{
+		"limit":         {"20"},
+		"bool":          {"true"},
+		"int":           {"-5"},
+		"uint":          {"7"},
+		"float":         {"8.25"},
+		"float32":       {"0.5"},
+		"string":        {"Game of Thrones"},
+		"order":         {"popularity"},
+		"locales":       {"fr,en"},
+		"ids":           {"1,2,3"},
+		"since":         {"1712102400"},
+		"date":          {"2024-04-03"},
+		"rating":        {"4.0"},
+		"filter[genre]": {"Drama"},
+		"filter[year]":  {"2011"},
+	}, values)
+}
+
+func TestEncodeQuery_QueryEncoder(t *testing.T) {
+	values := url.Values{}
+	assert.NoError(t, encodeQuery(episodeQuery{ID: 1161}, values))
+
+	assert.Equal(t, "id=1161&v=2", values.Encode())
+}
+
+func TestEncodeQuery_QueryEncoderPointerReceiver(t *testing.T) {
+	values := url.Values{}
+	assert.NoError(t, encodeQuery(struct {
+		Season seasonQuery `url:"season"`
+	}{Season: seasonQuery{Number: 2}}, values))
+
+	assert.Equal(t, "season=S2", values.Encode())
+
+	values = url.Values{}
+	assert.NoError(t, encodeQuery(&struct {
+		Season seasonQuery `url:"season"`
+	}{Season: seasonQuery{Number: 3}}, values))
+
+	assert.Equal(t, "season=S3", values.Encode())
+
+	values = url.Values{}
+	assert.NoError(t, encodeQuery(seasonQuery{Number: 4}, values))
+
+	assert.Equal(t, "season=S4", values.Encode())
+}
+
+func TestEncodeQuery_Stringer(t *testing.T) {
+	values := url.Values{}
+	assert.NoError(t, encodeQuery(struct {
+		Order sortOrder `url:"order"`
+	}{}, values))
+
+	// Integer kinds are formatted as numbers, String is only a fallback.
+	assert.Equal(t, "order=0", values.Encode())
+
+	values = url.Values{}
+	assert.NoError(t, encodeQuery(struct {
+		Orders []*DateTime `url:"dates"`
+	}{Orders: []*DateTime{(*DateTime)(&time.Time{})}}, values))
+
+	assert.Equal(t, "dates=0001-01-01+00%3A00%3A00", values.Encode())
+}
+
+func TestEncodeQuery_Unsupported(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params any
+		err    string
+	}{
+		{
+			name: "map",
+			params: struct {
+				Tags map[string]string `url:"tags"`
+			}{Tags: map[string]string{}},
+			err: "unsupported type map[string]string for parameter tags",
+		},
+		{
+			name: "slice of funcs",
+			params: struct {
+				Callbacks []func() `url:"callbacks"`
+			}{Callbacks: []func(){func() {}}},
+			err: "unsupported type func() for parameter callbacks",
+		},
+		{
+			name: "nested",
+			params: struct {
+				Filter struct {
+					C chan int `url:"c"`
+				} `url:"filter"`
+			}{},
+			err: "unsupported type chan int for parameter filter[c]",
+		},
+		{
+			name:   "not a struct",
+			params: 1161,
+			err:    "unsupported params type int",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := encodeQuery(tc.params, url.Values{})
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewRequest_UnsupportedParams(t *testing.T) {
+	client, err := NewClient("api_key")
+	assert.NoError(t, err)
+
+	_, err = client.newRequest(context.Background(), "GET", "/test", struct {
+		Tags map[string]string `url:"tags"`
+	}{})
+	assert.EqualError(t, err, "unsupported type map[string]string for parameter tags")
+}
+
+func TestNewRequest_Deterministic(t *testing.T) {
+	client, err := NewClient("api_key", WithBaseURL("http://api.test.com"), WithLocale(LocaleFR))
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		req, err := client.newRequest(context.Background(), "GET", "/shows/list", ShowsListParams{
+			Order:  Order(OrderPopularity),
+			Limit:  Int(10),
+			Start:  Int(20),
+			Filter: String("new"),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "http://api.test.com/shows/list?filter=new&limit=10&locale=fr&order=popularity&start=20", req.URL.String())
+	}
+}
@@ -0,0 +1,67 @@
+package gotaseries
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+)
+
+// Rate is the quota reported by the rate-limit headers of a response.
+// Fields are zero when the server did not send the corresponding header.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func parseRate(h http.Header) Rate {
+	var r Rate
+
+	if v := h.Get(headerRateLimit); v != "" {
+		r.Limit, _ = strconv.Atoi(strings.TrimSpace(v))
+	}
+
+	if v := h.Get(headerRateRemaining); v != "" {
+		r.Remaining, _ = strconv.Atoi(strings.TrimSpace(v))
+	}
+
+	if v := h.Get(headerRateReset); v != "" {
+		if reset, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			r.Reset = time.Unix(reset, 0)
+		}
+	}
+
+	return r
+}
+
+// parseRetryAfter returns the delay of a Retry-After header, given either in
+// seconds or as an HTTP date. It returns 0 when the header is missing or invalid.
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	v := strings.TrimSpace(h.Get(headerRetryAfter))
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
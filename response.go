@@ -0,0 +1,81 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const headerRequestID = "X-Request-Id"
+
+// Response holds the metadata of the HTTP response of a service call, see
+// CaptureResponse.
+type Response struct {
+	// StatusCode and Header are the ones of the last response received. They
+	// are zero when no response was received, for instance on a network error.
+	// A response served from the cache has a 200 status and no header.
+	StatusCode int
+	Header     http.Header
+	// Cached reports whether the response was served from the cache, see
+	// WithCache, without sending a request.
+	Cached bool
+	// RequestID is the identifier of the request set by the server, if any.
+	RequestID string
+	// Rate is the quota reported by the rate-limit headers of the response.
+	Rate Rate
+	// Attempts is the number of requests sent, more than 1 when retried.
+	Attempts int
+	// Elapsed is the time spent by the call, including the retries.
+	Elapsed time.Duration
+}
+
+type responseKey struct{}
+
+// CaptureResponse returns a context making the service calls using it store
+// the metadata of their HTTP response into r. The same context must not be
+// shared by concurrent calls.
+//
+// Example:
+//
+//	var res gotaseries.Response
+//	show, err := client.Shows.Display(gotaseries.CaptureResponse(ctx, &res), params)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s in %s, %d requests remaining\n", show.Title, res.Elapsed, res.Rate.Remaining)
+func CaptureResponse(ctx context.Context, r *Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, r)
+}
+
+func responseFromContext(ctx context.Context) *Response {
+	r, _ := ctx.Value(responseKey{}).(*Response)
+	return r
+}
+
+// record stores the metadata of an attempt, res being nil when no response was received.
+func (r *Response) record(res *http.Response, attempt int, start time.Time) {
+	if r == nil {
+		return
+	}
+
+	*r = Response{
+		Attempts: attempt,
+		Elapsed:  time.Since(start),
+	}
+
+	if res != nil {
+		r.StatusCode = res.StatusCode
+		r.Header = res.Header
+		r.RequestID = res.Header.Get(headerRequestID)
+		r.Rate = parseRate(res.Header)
+	}
+}
+
+// cached stores the metadata of a response served from the cache.
+func (r *Response) cached() {
+	if r == nil {
+		return
+	}
+
+	*r = Response{StatusCode: http.StatusOK, Cached: true}
+}
@@ -0,0 +1,72 @@
+package gotaseries
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc123")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "1712130000")
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	}))
+	defer ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	var res Response
+	show, err := bc.Shows.Display(CaptureResponse(context.Background(), &res), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1161, show.ID)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "abc123", res.RequestID)
+	assert.Equal(t, "abc123", res.Header.Get("X-Request-Id"))
+	assert.Equal(t, Rate{Limit: 100, Remaining: 42, Reset: time.Unix(1712130000, 0)}, res.Rate)
+	assert.Equal(t, 1, res.Attempts)
+	assert.Greater(t, res.Elapsed, time.Duration(0))
+}
+
+func TestCaptureResponse_Retried(t *testing.T) {
+	ts, bc, _ := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors": [{"code": 4001, "text": "No series found."}]}`))
+	})
+	defer ts.Close()
+
+	var res Response
+	_, err := bc.Shows.Display(CaptureResponse(context.Background(), &res), ShowsDisplayParams{ID: Int(1)})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.Equal(t, 2, res.Attempts)
+}
+
+func TestCaptureResponse_NetworkError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.Close()
+
+	bc, err := NewClient("api_key", WithBaseURL(ts.URL))
+	assert.NoError(t, err)
+
+	var res Response
+	_, err = bc.Shows.Display(CaptureResponse(context.Background(), &res), ShowsDisplayParams{ID: Int(1)})
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, res.StatusCode)
+	assert.Nil(t, res.Header)
+	assert.Equal(t, 1, res.Attempts)
+}
@@ -0,0 +1,160 @@
+package gotaseries
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how the client retries requests failing with a
+// transient error: a network error, a 429 or a 5xx status, even when its body
+// holds Betaseries errors. The other errors returned by the Betaseries API are
+// never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. It doubles on each retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. A Retry-After header asking
+	// for a longer delay stops the retries. Zero means no cap.
+	MaxBackoff time.Duration
+	// Jitter is the fraction, between 0 and 1, of the delay which is randomized.
+	Jitter float64
+	// Methods are the HTTP methods which are retried. Only GET requests are
+	// retried when empty, POST, PUT and DELETE are not idempotent on Betaseries.
+	Methods []string
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// DefaultRetryPolicy returns a policy making up to 3 attempts of GET requests.
+//
+// Example:
+//
+//	client, err := gotaseries.NewClient("YOUR_API_KEY", gotaseries.WithRetry(gotaseries.DefaultRetryPolicy()))
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+func (p *RetryPolicy) retryable(req *http.Request, attempt int, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if !p.allows(req.Method) {
+		return false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if code, retryAfter, ok := responseStatus(err); ok {
+		if !temporaryStatus(code) {
+			return false
+		}
+		return p.MaxBackoff <= 0 || retryAfter <= p.MaxBackoff
+	}
+
+	return isNetworkError(err)
+}
+
+func (p *RetryPolicy) allows(method string) bool {
+	if len(p.Methods) == 0 {
+		return method == http.MethodGet
+	}
+
+	for _, m := range p.Methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay to wait before the attempt following the given one.
+func (p *RetryPolicy) backoff(attempt int, err error) time.Duration {
+	if _, retryAfter, ok := responseStatus(err); ok && retryAfter > 0 {
+		return retryAfter
+	}
+
+	d := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && d > 0 {
+		d -= time.Duration(p.float64() * p.Jitter * float64(d))
+	}
+
+	return d
+}
+
+func (p *RetryPolicy) float64() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.rand == nil {
+		p.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return p.rand.Float64()
+}
+
+// isNetworkError reports whether err is a transient failure of the connection:
+// an error of the network operation, a timeout, a connection reset or a body
+// cut short. The other errors of the transport, e.g. a malformed URL or a TLS
+// certificate rejected, are not retried.
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || isConnReset(err) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
+
+// sleep waits for d or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
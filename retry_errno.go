@@ -0,0 +1,13 @@
+//go:build !plan9
+
+package gotaseries
+
+import (
+	"errors"
+	"syscall"
+)
+
+// isConnReset reports whether err is a connection reset by the server.
+func isConnReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET)
+}
@@ -0,0 +1,7 @@
+package gotaseries
+
+// isConnReset reports whether err is a connection reset by the server. Plan 9
+// has no errno, the resets are only caught as network errors.
+func isConnReset(err error) bool {
+	return false
+}
@@ -0,0 +1,285 @@
+package gotaseries
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupFlaky(t *testing.T, handler func(attempt int32, w http.ResponseWriter, r *http.Request)) (*httptest.Server, *Client, *int32) {
+	var attempts int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(atomic.AddInt32(&attempts, 1), w, r)
+	}))
+
+	c, err := NewClient("api_key",
+		WithBaseURL(ts.URL),
+		WithRetry(&RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  10 * time.Millisecond,
+		}),
+	)
+	assert.NoError(t, err)
+
+	return ts, c, &attempts
+}
+
+func TestRetry_TransientStatus(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("<html>Service Unavailable</html>"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"show": {"id": 1161, "title": "Game of Thrones"}}`))
+	})
+	defer ts.Close()
+
+	show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Game of Thrones", show.Title)
+	assert.Equal(t, int32(3), atomic.LoadInt32(attempts))
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+
+	var httpErr *HTTPError
+	assert.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusBadGateway, httpErr.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(attempts))
+}
+
+func TestRetry_ConnectionReset(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			assert.NoError(t, err)
+			if tcp, ok := conn.(*net.TCPConn); ok {
+				_ = tcp.SetLinger(0)
+			}
+			_ = conn.Close()
+			return
+		}
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	})
+	defer ts.Close()
+
+	show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1161, show.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(attempts))
+}
+
+func TestRetry_APIErrorNotRetried(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors": [{"code": 4001, "text": "No series found."}]}`))
+	})
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Equal(t, int32(1), atomic.LoadInt32(attempts))
+}
+
+func TestRetry_APIErrorTooManyRequests(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"errors": [{"code": 0, "text": "Too many requests."}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	})
+	defer ts.Close()
+
+	bc.Limiter = NewRateLimiter(100, 1)
+
+	show, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1161, show.ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(attempts))
+	// The limiter was throttled by the 429.
+	assert.Less(t, bc.Limiter.Limit(), float64(100))
+}
+
+func TestRetry_Methods(t *testing.T) {
+	testCases := []struct {
+		name     string
+		methods  []string
+		expected int32
+	}{
+		{name: "post not retried by default", methods: nil, expected: 1},
+		{name: "post retried when allowed", methods: []string{http.MethodGet, http.MethodPost}, expected: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+				if attempt == 1 {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, _ = w.Write([]byte(`{"show": {"id": 2}}`))
+			})
+			defer ts.Close()
+
+			bc.Retry.Methods = tc.methods
+
+			_, _ = bc.Shows.Add(context.Background(), ShowsAddParams{ID: Int(2)})
+
+			assert.Equal(t, tc.expected, atomic.LoadInt32(attempts))
+		})
+	}
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	var first time.Time
+	var second time.Time
+
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	})
+	defer ts.Close()
+
+	bc.Retry.MaxBackoff = 2 * time.Second
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(attempts))
+	assert.GreaterOrEqual(t, second.Sub(first), time.Second)
+}
+
+func TestRetry_RetryAfterTooLong(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+
+	var httpErr *HTTPError
+	assert.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, time.Hour, httpErr.RetryAfter)
+	assert.Equal(t, int32(1), atomic.LoadInt32(attempts))
+}
+
+func TestRetry_ContextCanceled(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer ts.Close()
+
+	bc.Retry.MinBackoff = time.Second
+	bc.Retry.MaxBackoff = time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := bc.Shows.Display(ctx, ShowsDisplayParams{ID: Int(1161)})
+	assert.Error(t, err)
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(attempts))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := &RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1, errors.New("reset")))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2, errors.New("reset")))
+	assert.Equal(t, 400*time.Millisecond, p.backoff(3, errors.New("reset")))
+	assert.Equal(t, time.Second, p.backoff(5, errors.New("reset")))
+	assert.Equal(t, 3*time.Second, p.backoff(1, &HTTPError{StatusCode: http.StatusTooManyRequests, RetryAfter: 3 * time.Second}))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.backoff(2, errors.New("reset"))
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, 200*time.Millisecond)
+	}
+}
+
+func TestRetryPolicy_BackoffNoCap(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1, errors.New("reset")))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2, errors.New("reset")))
+	assert.Equal(t, 1600*time.Millisecond, p.backoff(5, errors.New("reset")))
+}
+
+func TestRetry_RetryAfterNoCap(t *testing.T) {
+	ts, bc, attempts := setupFlaky(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	})
+	defer ts.Close()
+
+	bc.Retry.MaxBackoff = 0
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(attempts))
+}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"op error", &url.Error{Op: "Get", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, true},
+		{"timeout", &url.Error{Op: "Get", Err: &net.DNSError{IsTimeout: true}}, true},
+		{"unexpected eof", &url.Error{Op: "Get", Err: io.ErrUnexpectedEOF}, true},
+		{"context canceled", &url.Error{Op: "Get", Err: context.Canceled}, false},
+		{"certificate", &url.Error{Op: "Get", Err: x509.UnknownAuthorityError{}}, false},
+		{"unsupported scheme", &url.Error{Op: "Get", Err: errors.New(`unsupported protocol scheme "ftp"`)}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isNetworkError(tc.err))
+		})
+	}
+}
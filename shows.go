@@ -172,10 +172,8 @@ type Show struct {
 			Image *string `json:"image"`
 		} `json:"next"`
 		FriendsWatching []struct {
-			ID     int    `json:"id"`
-			Login  string `json:"login"`
-			Note   *int   `json:"note"`
-			Avatar string `json:"avatar"`
+			MemberSummary
+			Note *int `json:"note"`
 		} `json:"friends_watching"`
 	} `json:"user"`
 	NextTrailer     *string    `json:"next_trailer"`
@@ -433,6 +431,16 @@ func (s *ShowService) Search(ctx context.Context, params ShowsSearchParams) ([]S
 	return res.Shows, nil
 }
 
+// SearchPager returns a pager over the series matching the search, see Search.
+func (s *ShowService) SearchPager(params ShowsSearchParams) *Pager[Show] {
+	return newPagePager(func(ctx context.Context, page, perPage int) ([]Show, int, error) {
+		p := params
+		p.Page, p.PerPage = Int(page), Int(perPage)
+		items, err := s.Search(ctx, p)
+		return items, 0, err
+	}, params.Page, params.PerPage)
+}
+
 // Display returns information about a series.
 //
 // Example:
@@ -590,6 +598,16 @@ func (s *ShowService) Videos(ctx context.Context, params ShowsVideosParams) ([]V
 	return res.Videos, nil
 }
 
+// VideosPager returns a pager over the videos of the series, see Videos.
+func (s *ShowService) VideosPager(params ShowsVideosParams) *Pager[VideoShow] {
+	return newPager(func(ctx context.Context, offset, limit int) ([]VideoShow, int, error) {
+		p := params
+		p.Start, p.Limit = Int(offset), Int(limit)
+		items, err := s.Videos(ctx, p)
+		return items, 0, err
+	}, params.Start, params.Limit)
+}
+
 // Characters returns a list of characters for the series.
 func (s *ShowService) Characters(ctx context.Context, params ShowsCharactersParams) ([]CharacterShow, error) {
 	var res charactersShowResponse
@@ -608,6 +626,16 @@ func (s *ShowService) Pictures(ctx context.Context, params ShowsPicturesParams)
 	return res.Pictures, nil
 }
 
+// PicturesPager returns a pager over the pictures of the series, see Pictures.
+func (s *ShowService) PicturesPager(params ShowsPicturesParams) *Pager[PictureShow] {
+	return newPager(func(ctx context.Context, offset, limit int) ([]PictureShow, int, error) {
+		p := params
+		p.Start, p.Limit = Int(offset), Int(limit)
+		items, err := s.Pictures(ctx, p)
+		return items, 0, err
+	}, params.Start, params.Limit)
+}
+
 // Favorites returns a list of favorite series for the authenticated member or ID member. (ID member has priority over token)
 func (s *ShowService) Favorites(ctx context.Context, params ShowsFavoritesParams) (*FavoritesResponse, error) {
 	var res FavoritesResponse
@@ -617,6 +645,19 @@ func (s *ShowService) Favorites(ctx context.Context, params ShowsFavoritesParams
 	return &res, nil
 }
 
+// FavoritesPager returns a pager over the favorite series, see Favorites.
+func (s *ShowService) FavoritesPager(params ShowsFavoritesParams) *Pager[Show] {
+	return newPager(func(ctx context.Context, offset, limit int) ([]Show, int, error) {
+		p := params
+		p.Offset, p.Limit = Int(offset), Int(limit)
+		res, err := s.Favorites(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Shows, res.Total, nil
+	}, params.Offset, params.Limit)
+}
+
 // AddFavorite add a series to the member's favorite list.
 // Require a valid token.
 func (s *ShowService) AddFavorite(ctx context.Context, params ShowsAddFavoriteParams) (*Show, error) {
@@ -656,6 +697,19 @@ func (s *ShowService) Member(ctx context.Context, params ShowsMemberParams) (*Sh
 	return &res, nil
 }
 
+// MemberPager returns a pager over the series of the member, see Member.
+func (s *ShowService) MemberPager(params ShowsMemberParams) *Pager[Show] {
+	return newPager(func(ctx context.Context, offset, limit int) ([]Show, int, error) {
+		p := params
+		p.Offset, p.Limit = Int(offset), Int(limit)
+		res, err := s.Member(ctx, p)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Shows, res.Total, nil
+	}, params.Offset, params.Limit)
+}
+
 // Discover returns a list of series to discover.
 func (s *ShowService) Discover(ctx context.Context, params ShowsDiscoverParams) ([]Show, error) {
 	var res showsResponse
@@ -711,3 +765,13 @@ func (s *ShowService) Unrated(ctx context.Context, params ShowsUnratedParams) ([
 	}
 	return res.Shows, nil
 }
+
+// UnratedPager returns a pager over the finished and unrated series, see Unrated.
+func (s *ShowService) UnratedPager(params ShowsUnratedParams) *Pager[Show] {
+	return newPagePager(func(ctx context.Context, page, perPage int) ([]Show, int, error) {
+		p := params
+		p.Page, p.PerPage = Int(page), Int(perPage)
+		items, err := s.Unrated(ctx, p)
+		return items, 0, err
+	}, params.Page, params.PerPage)
+}
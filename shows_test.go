@@ -162,6 +162,8 @@ func TestShowService_DisplayWithToken(t *testing.T) {
 	assert.Equal(t, "S10E10", show.User.Next.Code)
 	assert.Equal(t, "2018-12-12", show.User.Next.Date.String())
 	assert.Equal(t, "test user", show.User.FriendsWatching[0].Login)
+	assert.Equal(t, 9876, show.User.FriendsWatching[0].ID)
+	assert.Equal(t, Int(5), show.User.FriendsWatching[0].Note)
 }
 
 func TestShowService_DisplayNotFound(t *testing.T) {
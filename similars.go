@@ -13,3 +13,16 @@ type SimilarShow struct {
 	Notes     *string `json:"notes"`
 	Show      *Show   `json:"show"`
 }
+
+type similarsMovieResponse struct {
+	Similars []SimilarMovie `json:"similars"`
+	Errors   Errors         `json:"errors"`
+}
+
+type SimilarMovie struct {
+	ID      int     `json:"id"`
+	Title   string  `json:"movie_title"`
+	MovieID int     `json:"movie_id"`
+	Notes   *string `json:"notes"`
+	Movie   *Movie  `json:"movie"`
+}
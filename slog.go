@@ -0,0 +1,130 @@
+//go:build go1.21
+
+package gotaseries
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// SlogOptions configures the middleware returned by SlogMiddleware.
+type SlogOptions struct {
+	// Level of the successful requests, slog.LevelInfo by default.
+	Level slog.Leveler
+	// APIErrorLevel of the requests failing with errors returned by the
+	// Betaseries API, like a series not found, slog.LevelWarn by default.
+	APIErrorLevel slog.Leveler
+	// ErrorLevel of the requests failing with a network or an HTTP error,
+	// slog.LevelError by default.
+	ErrorLevel slog.Leveler
+	// Headers adds the request headers to the records, with the API key and
+	// the token redacted.
+	Headers bool
+}
+
+type slogMiddleware struct {
+	logger *slog.Logger
+	opts   SlogOptions
+}
+
+// SlogMiddleware returns a middleware logging each request with its method,
+// path, query string, status, latency and Betaseries error codes. The API key
+// and the token are redacted. opts can be nil.
+//
+// Example:
+//
+//	client, err := gotaseries.NewClient("YOUR_API_KEY",
+//		gotaseries.WithMiddleware(gotaseries.SlogMiddleware(slog.Default(), nil)),
+//	)
+func SlogMiddleware(logger *slog.Logger, opts *SlogOptions) Middleware {
+	m := slogMiddleware{logger: logger}
+	if opts != nil {
+		m.opts = *opts
+	}
+
+	if m.opts.Level == nil {
+		m.opts.Level = slog.LevelInfo
+	}
+	if m.opts.APIErrorLevel == nil {
+		m.opts.APIErrorLevel = slog.LevelWarn
+	}
+	if m.opts.ErrorLevel == nil {
+		m.opts.ErrorLevel = slog.LevelError
+	}
+
+	return m
+}
+
+func (m slogMiddleware) BeforeSend(req *http.Request) (*http.Request, error) {
+	return loggingMiddleware{}.BeforeSend(req)
+}
+
+func (m slogMiddleware) AfterReceive(req *http.Request, res *http.Response, err error) {
+	level := m.opts.Level.Level()
+	msg := "betaseries request"
+
+	var apiErrs APIErrors
+	if err != nil {
+		msg = "betaseries request failed"
+		level = m.opts.ErrorLevel.Level()
+		if errors.As(err, &apiErrs) {
+			level = m.opts.APIErrorLevel.Level()
+		}
+	}
+
+	ctx := req.Context()
+	if !m.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	}
+
+	if q := sanitizeQuery(req.URL); q != "" {
+		attrs = append(attrs, slog.String("query", q))
+	}
+
+	if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+	}
+
+	if start, ok := ctx.Value(startKey{}).(time.Time); ok {
+		attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+	}
+
+	if len(apiErrs) > 0 {
+		codes := make([]int, 0, len(apiErrs))
+		for _, e := range apiErrs {
+			codes = append(codes, e.Code)
+		}
+		attrs = append(attrs, slog.Any("error_codes", codes))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	if m.opts.Headers {
+		h := req.Header.Clone()
+		redact(h)
+
+		keys := make([]string, 0, len(h))
+		for k := range h {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		headers := make([]any, 0, len(keys))
+		for _, k := range keys {
+			headers = append(headers, slog.String(k, h.Get(k)))
+		}
+		attrs = append(attrs, slog.Group("headers", headers...))
+	}
+
+	m.logger.LogAttrs(ctx, level, msg, attrs...)
+}
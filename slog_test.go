@@ -0,0 +1,119 @@
+//go:build go1.21
+
+package gotaseries
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSlog(t *testing.T, status int, body string, opts *SlogOptions) (*httptest.Server, *Client, *bytes.Buffer) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	bc, err := NewClient("api_key",
+		WithBaseURL(ts.URL),
+		WithToken("secret_token"),
+		WithMiddleware(SlogMiddleware(logger, opts)),
+	)
+	assert.NoError(t, err)
+
+	return ts, bc, &buf
+}
+
+func decodeRecord(t *testing.T, buf *bytes.Buffer) map[string]any {
+	var record map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	return record
+}
+
+func TestSlogMiddleware(t *testing.T) {
+	ts, bc, buf := setupSlog(t, http.StatusOK, `{"show": {"id": 1161}}`, nil)
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "betaseries request", record["msg"])
+	assert.Equal(t, "GET", record["method"])
+	assert.Equal(t, "/shows/display", record["path"])
+	assert.Equal(t, "id=1161", record["query"])
+	assert.Equal(t, float64(200), record["status"])
+	assert.Contains(t, record, "latency")
+	assert.NotContains(t, record, "headers")
+	assert.NotContains(t, buf.String(), "secret_token")
+}
+
+func TestSlogMiddleware_APIError(t *testing.T) {
+	ts, bc, buf := setupSlog(t, http.StatusBadRequest, `{"errors": [{"code": 4001, "text": "No series found."}]}`, nil)
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1)})
+	assert.Error(t, err)
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "WARN", record["level"])
+	assert.Equal(t, "betaseries request failed", record["msg"])
+	assert.Equal(t, []any{float64(4001)}, record["error_codes"])
+	assert.Equal(t, "Code: 4001, Message: No series found.\n", record["error"])
+}
+
+func TestSlogMiddleware_HTTPError(t *testing.T) {
+	ts, bc, buf := setupSlog(t, http.StatusBadGateway, `<html>Bad Gateway</html>`, &SlogOptions{ErrorLevel: slog.LevelWarn})
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1)})
+	assert.Error(t, err)
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "WARN", record["level"])
+	assert.Equal(t, float64(502), record["status"])
+	assert.NotContains(t, record, "error_codes")
+}
+
+func TestSlogMiddleware_Redacted(t *testing.T) {
+	ts, bc, buf := setupSlog(t, http.StatusOK, `{"show": {"id": 1161}}`, &SlogOptions{Level: slog.LevelDebug, Headers: true})
+	defer ts.Close()
+
+	var res showResponse
+	err := bc.doRequest(context.Background(), http.MethodGet, "/shows/display", struct {
+		ID    int    `url:"id"`
+		Key   string `url:"key"`
+		Token string `url:"token"`
+	}{ID: 1161, Key: "api_key", Token: "secret_token"}, &res)
+	assert.NoError(t, err)
+
+	record := decodeRecord(t, buf)
+	assert.Equal(t, "DEBUG", record["level"])
+	assert.Equal(t, "id=1161&key=REDACTED&token=REDACTED", record["query"])
+
+	headers := record["headers"].(map[string]any)
+	assert.Equal(t, "REDACTED", headers["X-Betaseries-Key"])
+	assert.Equal(t, "REDACTED", headers["X-Betaseries-Token"])
+	assert.NotContains(t, buf.String(), "api_key")
+	assert.NotContains(t, buf.String(), "secret_token")
+}
+
+func TestSlogMiddleware_Disabled(t *testing.T) {
+	ts, bc, buf := setupSlog(t, http.StatusOK, `{"show": {"id": 1161}}`, &SlogOptions{Level: slog.LevelDebug - 1})
+	defer ts.Close()
+
+	_, err := bc.Shows.Display(context.Background(), ShowsDisplayParams{ID: Int(1161)})
+	assert.NoError(t, err)
+
+	assert.Empty(t, buf.String())
+}
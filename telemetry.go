@@ -0,0 +1,150 @@
+package gotaseries
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names of the metrics recorded by the client.
+const (
+	// MetricRequests counts the service calls.
+	MetricRequests = "betaseries.client.requests"
+	// MetricErrors counts the service calls returning an error.
+	MetricErrors = "betaseries.client.errors"
+	// MetricDuration records the duration of the service calls in seconds,
+	// retries included.
+	MetricDuration = "betaseries.client.duration"
+)
+
+// Attribute is a key-value pair describing a span or a measurement. Value is a
+// string, an int or a float64.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer starts a span for each service call, see WithTracer. It can be
+// implemented on top of an OpenTelemetry tracer without gotaseries depending
+// on it.
+type Tracer interface {
+	// Start starts a span and returns a context holding it. The context is the
+	// one of the HTTP requests of the call.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Meter records the metrics of the service calls, see WithMeter.
+type Meter interface {
+	// Add adds value to the counter name.
+	Add(ctx context.Context, name string, value int64, attrs ...Attribute)
+	// Record records value in the histogram name.
+	Record(ctx context.Context, name string, value float64, attrs ...Attribute)
+}
+
+// instrument starts the span of a service call. The returned function ends it
+// and records the metrics of the call given its last response and its error.
+//
+// Spans are named after the endpoint, e.g. "betaseries shows/display", and have
+// the attributes betaseries.endpoint, http.method, betaseries.locale and
+// betaseries.show.id when set, http.status_code and betaseries.error_code.
+// Metrics have the endpoint, method, status and error code attributes only.
+func (c *Client) instrument(req *http.Request, urlStr string) (*http.Request, func(*http.Response, error)) {
+	if c.tracer == nil && c.meter == nil {
+		return req, func(*http.Response, error) {}
+	}
+
+	endpoint := strings.TrimPrefix(urlStr, "/")
+	attrs := []Attribute{
+		{Key: "betaseries.endpoint", Value: endpoint},
+		{Key: "http.method", Value: req.Method},
+	}
+
+	spanAttrs := attrs
+	params := requestParams(req)
+	if locale := params.Get("locale"); locale != "" {
+		spanAttrs = append(spanAttrs, Attribute{Key: "betaseries.locale", Value: locale})
+	}
+	if id := params.Get("id"); id != "" && strings.HasPrefix(endpoint, "shows/") {
+		spanAttrs = append(spanAttrs, Attribute{Key: "betaseries.show.id", Value: attributeValue(id)})
+	}
+
+	ctx := req.Context()
+	var span Span
+	if c.tracer != nil {
+		ctx, span = c.tracer.Start(ctx, "betaseries "+endpoint, spanAttrs...)
+		req = req.WithContext(ctx)
+	}
+
+	start := time.Now()
+
+	return req, func(res *http.Response, err error) {
+		var result []Attribute
+		if res != nil {
+			result = append(result, Attribute{Key: "http.status_code", Value: res.StatusCode})
+		}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			result = append(result, Attribute{Key: "betaseries.error_code", Value: apiErr.Code})
+		}
+
+		if span != nil {
+			span.SetAttributes(result...)
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}
+
+		if c.meter != nil {
+			attrs := append(attrs[:len(attrs):len(attrs)], result...)
+			c.meter.Add(ctx, MetricRequests, 1, attrs...)
+			if err != nil {
+				c.meter.Add(ctx, MetricErrors, 1, attrs...)
+			}
+			c.meter.Record(ctx, MetricDuration, time.Since(start).Seconds(), attrs...)
+		}
+	}
+}
+
+// requestParams returns the parameters of a request, from its query string or
+// its form-encoded body.
+func requestParams(req *http.Request) url.Values {
+	if req.Header.Get("Content-Type") != formContentType || req.GetBody == nil {
+		return req.URL.Query()
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return url.Values{}
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return url.Values{}
+	}
+
+	params, _ := url.ParseQuery(string(data))
+	return params
+}
+
+// attributeValue returns v as an int when it is one.
+func attributeValue(v string) any {
+	if i, err := strconv.Atoi(v); err == nil {
+		return i
+	}
+	return v
+}
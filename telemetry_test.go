@@ -0,0 +1,119 @@
+package gotaseries_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/florentsorel/gotaseries"
+	"github.com/florentsorel/gotaseries/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTelemetry(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *gotaseries.Client, *telemetrytest.Recorder) {
+	ts := httptest.NewServer(handler)
+	rec := telemetrytest.NewRecorder()
+
+	bc, err := gotaseries.NewClient("api_key",
+		gotaseries.WithBaseURL(ts.URL),
+		gotaseries.WithLocale(gotaseries.LocaleFR),
+		gotaseries.WithTracer(rec),
+		gotaseries.WithMeter(rec),
+	)
+	assert.NoError(t, err)
+
+	return ts, bc, rec
+}
+
+func TestTelemetry(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"show": {"id": 1161}}`))
+	}))
+	defer ts.Close()
+
+	// The span is held by the context of the HTTP requests.
+	var spanName string
+	rec := telemetrytest.NewRecorder()
+
+	bc, err := gotaseries.NewClient("api_key",
+		gotaseries.WithBaseURL(ts.URL),
+		gotaseries.WithLocale(gotaseries.LocaleFR),
+		gotaseries.WithTracer(rec),
+		gotaseries.WithMeter(rec),
+		gotaseries.WithMiddleware(gotaseries.BeforeSendFunc(func(req *http.Request) (*http.Request, error) {
+			if span := telemetrytest.SpanFromContext(req.Context()); span != nil {
+				spanName = span.Name
+			}
+			return req, nil
+		})),
+	)
+	assert.NoError(t, err)
+
+	_, err = bc.Shows.Display(context.Background(), gotaseries.ShowsDisplayParams{ID: gotaseries.Int(1161)})
+	assert.NoError(t, err)
+
+	spans := rec.Spans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "betaseries shows/display", spans[0].Name)
+	assert.Equal(t, "betaseries shows/display", spanName)
+	assert.True(t, spans[0].Ended)
+	assert.Empty(t, spans[0].Errors)
+	assert.Equal(t, map[string]any{
+		"betaseries.endpoint": "shows/display",
+		"http.method":         "GET",
+		"betaseries.locale":   "fr",
+		"betaseries.show.id":  1161,
+		"http.status_code":    200,
+	}, spans[0].Attributes)
+
+	endpoint := gotaseries.Attribute{Key: "betaseries.endpoint", Value: "shows/display"}
+	assert.Equal(t, int64(1), rec.Counter(gotaseries.MetricRequests, endpoint))
+	assert.Equal(t, int64(0), rec.Counter(gotaseries.MetricErrors, endpoint))
+	assert.Len(t, rec.Histogram(gotaseries.MetricDuration, endpoint), 1)
+}
+
+func TestTelemetry_APIError(t *testing.T) {
+	ts, bc, rec := setupTelemetry(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors": [{"code": 2003, "text": "Show already added."}]}`))
+	})
+	defer ts.Close()
+
+	_, err := bc.Shows.Add(context.Background(), gotaseries.ShowsAddParams{ID: gotaseries.Int(1161)})
+	assert.ErrorIs(t, err, gotaseries.ErrAlreadyInAccount)
+
+	spans := rec.Spans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "betaseries shows/show", spans[0].Name)
+	assert.Equal(t, 1161, spans[0].Attributes["betaseries.show.id"])
+	assert.Equal(t, 2003, spans[0].Attributes["betaseries.error_code"])
+	assert.Len(t, spans[0].Errors, 1)
+
+	code := gotaseries.Attribute{Key: "betaseries.error_code", Value: 2003}
+	assert.Equal(t, int64(1), rec.Counter(gotaseries.MetricRequests, code))
+	assert.Equal(t, int64(1), rec.Counter(gotaseries.MetricErrors, code, gotaseries.Attribute{Key: "http.method", Value: "POST"}))
+}
+
+func TestTelemetry_PerEndpoint(t *testing.T) {
+	ts, bc, rec := setupTelemetry(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"shows": [], "episodes": []}`))
+	})
+	defer ts.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := bc.Shows.List(context.Background(), gotaseries.ShowsListParams{})
+		assert.NoError(t, err)
+	}
+	_, err := bc.Episodes.Unrated(context.Background(), gotaseries.EpisodesUnratedParams{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(4), rec.Counter(gotaseries.MetricRequests))
+	assert.Equal(t, int64(3), rec.Counter(gotaseries.MetricRequests, gotaseries.Attribute{Key: "betaseries.endpoint", Value: "shows/list"}))
+	assert.Equal(t, int64(1), rec.Counter(gotaseries.MetricRequests, gotaseries.Attribute{Key: "betaseries.endpoint", Value: "episodes/unrated"}))
+	assert.Len(t, rec.Histogram(gotaseries.MetricDuration, gotaseries.Attribute{Key: "betaseries.endpoint", Value: "shows/list"}), 3)
+
+	for _, s := range rec.Spans() {
+		assert.NotContains(t, s.Attributes, "betaseries.show.id")
+	}
+}
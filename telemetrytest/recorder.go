@@ -0,0 +1,198 @@
+// Package telemetrytest provides an in-memory tracer and meter recording the
+// spans and the metrics of a gotaseries client.
+package telemetrytest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/florentsorel/gotaseries"
+)
+
+// Recorder is a gotaseries.Tracer and a gotaseries.Meter keeping the spans and
+// the measurements in memory. It is safe for concurrent use.
+//
+// Example:
+//
+//	rec := telemetrytest.NewRecorder()
+//	client, err := gotaseries.NewClient("YOUR_API_KEY", gotaseries.WithTracer(rec), gotaseries.WithMeter(rec))
+type Recorder struct {
+	mu           sync.Mutex
+	spans        []*Span
+	counters     []Measurement
+	measurements []Measurement
+}
+
+// Span is a span recorded by a Recorder.
+type Span struct {
+	Name       string
+	Attributes map[string]any
+	Errors     []error
+	Ended      bool
+
+	rec *Recorder
+}
+
+// Measurement is a value added to a counter or recorded in a histogram.
+type Measurement struct {
+	Name       string
+	Value      float64
+	Attributes map[string]any
+}
+
+type spanKey struct{}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Start records a new span.
+func (r *Recorder) Start(ctx context.Context, name string, attrs ...gotaseries.Attribute) (context.Context, gotaseries.Span) {
+	s := &Span{
+		Name:       name,
+		Attributes: make(map[string]any),
+		rec:        r,
+	}
+	s.SetAttributes(attrs...)
+
+	r.mu.Lock()
+	r.spans = append(r.spans, s)
+	r.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// SpanFromContext returns the span started by a Recorder held by ctx, or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+func (s *Span) SetAttributes(attrs ...gotaseries.Attribute) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+
+	for _, a := range attrs {
+		s.Attributes[a.Key] = a.Value
+	}
+}
+
+func (s *Span) RecordError(err error) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+
+	s.Errors = append(s.Errors, err)
+}
+
+func (s *Span) End() {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+
+	s.Ended = true
+}
+
+// Add records a counter increment.
+func (r *Recorder) Add(ctx context.Context, name string, value int64, attrs ...gotaseries.Attribute) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.counters = append(r.counters, newMeasurement(name, float64(value), attrs))
+}
+
+// Record records a histogram value.
+func (r *Recorder) Record(ctx context.Context, name string, value float64, attrs ...gotaseries.Attribute) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.measurements = append(r.measurements, newMeasurement(name, value, attrs))
+}
+
+// Spans returns a copy of the recorded spans.
+func (r *Recorder) Spans() []Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := make([]Span, 0, len(r.spans))
+	for _, s := range r.spans {
+		c := *s
+		c.Attributes = make(map[string]any, len(s.Attributes))
+		for k, v := range s.Attributes {
+			c.Attributes[k] = v
+		}
+		c.Errors = append([]error(nil), s.Errors...)
+		spans = append(spans, c)
+	}
+
+	return spans
+}
+
+// Counter returns the sum of the increments of the counter name having the
+// given attributes, among others.
+func (r *Recorder) Counter(name string, attrs ...gotaseries.Attribute) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var sum int64
+	for _, m := range r.counters {
+		if m.matches(name, attrs) {
+			sum += int64(m.Value)
+		}
+	}
+
+	return sum
+}
+
+// Histogram returns the values recorded in the histogram name having the
+// given attributes, among others.
+func (r *Recorder) Histogram(name string, attrs ...gotaseries.Attribute) []float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var values []float64
+	for _, m := range r.measurements {
+		if m.matches(name, attrs) {
+			values = append(values, m.Value)
+		}
+	}
+
+	return values
+}
+
+// Reset removes the recorded spans and measurements.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.spans = nil
+	r.counters = nil
+	r.measurements = nil
+}
+
+func newMeasurement(name string, value float64, attrs []gotaseries.Attribute) Measurement {
+	m := Measurement{
+		Name:       name,
+		Value:      value,
+		Attributes: make(map[string]any, len(attrs)),
+	}
+
+	for _, a := range attrs {
+		m.Attributes[a.Key] = a.Value
+	}
+
+	return m
+}
+
+func (m Measurement) matches(name string, attrs []gotaseries.Attribute) bool {
+	if m.Name != name {
+		return false
+	}
+
+	for _, a := range attrs {
+		if v, ok := m.Attributes[a.Key]; !ok || v != a.Value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -34,6 +34,15 @@ func (genres *Genres) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if len(data) > 0 && data[0] == '[' {
+		var list []string
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		*genres = list
+		return nil
+	}
+
 	var g map[string]string
 
 	if err := json.Unmarshal(data, &g); err != nil {
@@ -24,3 +24,19 @@ type VideoShow struct {
 	Season  int      `json:"season"`
 	Episode int      `json:"episode"`
 }
+
+type videosMovieResponse struct {
+	Videos []VideoMovie `json:"videos"`
+	Errors Errors       `json:"errors"`
+}
+
+type VideoMovie struct {
+	ID      int      `json:"id"`
+	MovieID int      `json:"movie_id"`
+	Host    string   `json:"host"`
+	Slug    string   `json:"slug"`
+	URL     string   `json:"url"`
+	Date    DateTime `json:"date"`
+	Title   string   `json:"title"`
+	Type    string   `json:"type"`
+}